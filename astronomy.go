@@ -0,0 +1,206 @@
+package ptime
+
+import (
+	"math"
+	"time"
+)
+
+// iranOffset is the offset of Iran Standard Time (the mean solar time of 52.5°E) in days.
+const iranOffset = 3.5 / 24
+
+// An equinox specifies an equinox or a solstice of a Gregorian year.
+type equinox int
+
+// List of equinoxes and solstices.
+const (
+	marchEquinox equinox = iota
+	juneSolstice
+	septemberEquinox
+	decemberSolstice
+)
+
+// equinoxPolynomials are the coefficients of the mean equinoxes and solstices in Julian Ephemeris Days,
+// for the years -1000 to 1000 and 1000 to 3000 respectively (Meeus, Astronomical Algorithms, table 27.A and 27.B).
+var equinoxPolynomials = [2][4][5]float64{
+	{
+		{1721139.29189, 365242.13740, 0.06134, 0.00111, -0.00071},
+		{1721233.25401, 365241.72562, -0.05323, 0.00907, 0.00025},
+		{1721325.70455, 365242.49558, -0.11677, -0.00297, 0.00074},
+		{1721414.39987, 365242.88257, -0.00769, -0.00933, -0.00006},
+	},
+	{
+		{2451623.80984, 365242.37404, 0.05169, -0.00411, -0.00057},
+		{2451716.56767, 365241.62603, 0.00325, 0.00888, -0.00030},
+		{2451810.21715, 365242.01767, -0.11575, 0.00337, 0.00078},
+		{2451900.05952, 365242.74049, -0.06223, -0.00823, 0.00032},
+	},
+}
+
+// equinoxTerms are the periodic terms A, B and C of the equinoxes and solstices (Meeus, table 27.C).
+var equinoxTerms = [24][3]float64{
+	{485, 324.96, 1934.136},
+	{203, 337.23, 32964.467},
+	{199, 342.08, 20.186},
+	{182, 27.85, 445267.112},
+	{156, 73.14, 45036.886},
+	{136, 171.52, 22518.443},
+	{77, 222.54, 65928.934},
+	{74, 296.72, 3034.906},
+	{70, 243.58, 9037.513},
+	{58, 119.81, 33718.147},
+	{52, 297.17, 150.678},
+	{50, 21.02, 2281.226},
+	{45, 247.54, 29929.562},
+	{44, 325.15, 31555.956},
+	{29, 60.93, 4443.417},
+	{18, 155.12, 67555.328},
+	{17, 288.79, 4562.452},
+	{16, 198.04, 62894.029},
+	{14, 199.76, 31436.921},
+	{12, 95.39, 14577.848},
+	{12, 287.11, 31931.756},
+	{12, 320.81, 34777.259},
+	{9, 227.73, 1222.114},
+	{8, 15.45, 16859.074},
+}
+
+// equinoxJD returns the Julian Date in Universal Time of the equinox or solstice e of the Gregorian year.
+// The error is about a minute for the years 1000 to 3000 and grows outside of them. The moments outside
+// the years -1000 to 3000 are extrapolated from the moments of these years by the mean tropical year.
+func equinoxJD(year int, e equinox) float64 {
+	switch {
+	case year < -1000:
+		return equinoxJD(-1000, e) + float64(year+1000)*meanTropicalYear
+	case year > 3000:
+		return equinoxJD(3000, e) + float64(year-3000)*meanTropicalYear
+	}
+
+	p, y := &equinoxPolynomials[0][e], float64(year)/1000
+	if year >= 1000 {
+		p, y = &equinoxPolynomials[1][e], float64(year-2000)/1000
+	}
+
+	jde0 := p[0] + y*(p[1]+y*(p[2]+y*(p[3]+y*p[4])))
+
+	t := (jde0 - 2451545) / 36525
+	w := degToRad(35999.373*t - 2.47)
+	dl := 1 + 0.0334*math.Cos(w) + 0.0007*math.Cos(2*w)
+
+	var s float64
+	for _, term := range equinoxTerms {
+		s += term[0] * math.Cos(degToRad(term[1]+term[2]*t))
+	}
+
+	jde := jde0 + 0.00001*s/dl
+
+	return jde - deltaT(float64(year)+(float64(e)+0.75)/4)/86400
+}
+
+// deltaT returns the difference between Terrestrial Time and Universal Time in seconds
+// in the decimal Gregorian year (Espenak and Meeus, Five Millennium Canon of Solar Eclipses).
+func deltaT(year float64) float64 {
+	switch {
+	case year < -500:
+		u := (year - 1820) / 100
+		return -20 + 32*u*u
+	case year < 500:
+		u := year / 100
+		return 10583.6 + u*(-1014.41+u*(33.78311+u*(-5.952053+u*(-0.1798452+u*(0.022174192+u*0.0090316521)))))
+	case year < 1600:
+		u := (year - 1000) / 100
+		return 1574.2 + u*(-556.01+u*(71.23472+u*(0.319781+u*(-0.8503463+u*(-0.005050998+u*0.0083572073)))))
+	case year < 1700:
+		t := year - 1600
+		return 120 + t*(-0.9808+t*(-0.01532+t/7129))
+	case year < 1800:
+		t := year - 1700
+		return 8.83 + t*(0.1603+t*(-0.0059285+t*(0.00013336-t/1174000)))
+	case year < 1860:
+		t := year - 1800
+		return 13.72 + t*(-0.332447+t*(0.0068612+t*(0.0041116+t*(-0.00037436+t*(0.0000121272+t*(-0.0000001699+t*0.000000000875))))))
+	case year < 1900:
+		t := year - 1860
+		return 7.62 + t*(0.5737+t*(-0.251754+t*(0.01680668+t*(-0.0004473624+t/233174))))
+	case year < 1920:
+		t := year - 1900
+		return -2.79 + t*(1.494119+t*(-0.0598939+t*(0.0061966-t*0.000197)))
+	case year < 1941:
+		t := year - 1920
+		return 21.20 + t*(0.84493+t*(-0.076100+t*0.0020936))
+	case year < 1961:
+		t := year - 1950
+		return 29.07 + t*(0.407+t*(-1.0/233+t/2547))
+	case year < 1986:
+		t := year - 1975
+		return 45.45 + t*(1.067+t*(-1.0/260-t/718))
+	case year < 2005:
+		t := year - 2000
+		return 63.86 + t*(0.3345+t*(-0.060374+t*(0.0017275+t*(0.000651814+t*0.00002373599))))
+	case year < 2050:
+		t := year - 2000
+		return 62.92 + t*(0.32217+t*0.005589)
+	case year < 2150:
+		u := (year - 1820) / 100
+		return -20 + 32*u*u - 0.5628*(2150-year)
+	default:
+		u := (year - 1820) / 100
+		return -20 + 32*u*u
+	}
+}
+
+// equationOfTime returns the difference between the apparent and the mean solar time
+// at the Julian Date in days (Meeus, formula 28.3).
+func equationOfTime(jd float64) float64 {
+	t := (jd - 2451545) / 36525
+
+	l0 := degToRad(280.46646 + t*(36000.76983+t*0.0003032))
+	m := degToRad(357.52911 + t*(35999.05029-t*0.0001537))
+	e := 0.016708634 - t*(0.000042037+t*0.0000001267)
+	eps := degToRad(23.439291 - t*0.0130042)
+
+	y := math.Tan(eps / 2)
+	y *= y
+
+	eot := y*math.Sin(2*l0) - 2*e*math.Sin(m) + 4*e*y*math.Sin(m)*math.Cos(2*l0) -
+		0.5*y*y*math.Sin(4*l0) - 1.25*e*e*math.Sin(2*m)
+
+	// eot is in radians, where a full turn is a day.
+	return eot / (2 * math.Pi)
+}
+
+// degToRad converts degrees to radians.
+func degToRad(deg float64) float64 {
+	return deg * math.Pi / 180
+}
+
+// TahvilSal returns the moment of the vernal equinox which begins the Persian year (Tahvil-e Sal)
+// in loc. If loc is nil then the local time is used. See SeasonStart.
+func TahvilSal(year int, loc *time.Location) Time {
+	return SeasonStart(year, Bahar, loc)
+}
+
+// SeasonStart returns the moment of the equinox or the solstice which begins the astronomical season of
+// the Persian year in loc, i.e. the March equinox for Bahar, the June solstice for Tabestan, the September
+// equinox for Paeez and the December solstice for Zemestan. If loc is nil then the local time is used.
+//
+// The moment is computed by the periodic terms of Meeus (Astronomical Algorithms, chapter 27), and it is
+// rounded to the second. The error is less than a minute for the years 1330 to 1430 and grows further from
+// the present time, as the rotation of the Earth can only be extrapolated. The moments before -1621 and
+// after 2379 are extrapolated by the mean tropical year.
+func SeasonStart(year int, season Season, loc *time.Location) Time {
+	if loc == nil {
+		//nolint:gosmopolitan
+		loc = time.Local
+	}
+
+	jd := equinoxJD(year+621, equinox(season.index()))
+
+	return New(jdToTime(jd).In(loc))
+}
+
+// jdToTime returns the time of the Julian Date in Universal Time rounded to the second.
+func jdToTime(jd float64) time.Time {
+	sec := math.Round((jd - unixEpochJDN + 0.5) * 86400)
+
+	return time.Unix(int64(sec), 0)
+}
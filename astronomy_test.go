@@ -0,0 +1,52 @@
+package ptime_test
+
+import (
+	"testing"
+	"time"
+
+	ptime "github.com/yaa110/go-persian-calendar"
+)
+
+func TestSeasonStart(t *testing.T) {
+	tests := []struct {
+		year   int
+		season ptime.Season
+		want   time.Time
+	}{
+		{1402, ptime.Bahar, time.Date(2023, time.March, 20, 21, 24, 24, 0, time.UTC)},
+		{1403, ptime.Bahar, time.Date(2024, time.March, 20, 3, 6, 21, 0, time.UTC)},
+		{1403, ptime.Tabestan, time.Date(2024, time.June, 20, 20, 50, 56, 0, time.UTC)},
+		{1403, ptime.Paeez, time.Date(2024, time.September, 22, 12, 43, 40, 0, time.UTC)},
+		{1403, ptime.Zemestan, time.Date(2024, time.December, 21, 9, 20, 30, 0, time.UTC)},
+		{1404, ptime.Bahar, time.Date(2025, time.March, 20, 9, 1, 25, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		got := ptime.SeasonStart(tt.year, tt.season, time.UTC).Time()
+		if d := got.Sub(tt.want); d < -time.Minute || d > time.Minute {
+			t.Error(
+				"For", tt.year, tt.season,
+				"expected", tt.want,
+				"got", got,
+			)
+		}
+	}
+}
+
+func TestTahvilSal(t *testing.T) {
+	pt := ptime.TahvilSal(1403, ptime.Iran())
+
+	if pt.Format("yyyy-MM-dd HH:mm") != "1403-01-01 06:36" {
+		t.Error(
+			"Expected", "1403-01-01 06:36",
+			"got", pt.Format("yyyy-MM-dd HH:mm"),
+		)
+	}
+
+	if pt.Location().String() != "Asia/Tehran" {
+		t.Error(
+			"Expected", "Asia/Tehran",
+			"got", pt.Location(),
+		)
+	}
+}
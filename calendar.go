@@ -0,0 +1,215 @@
+package ptime
+
+import (
+	"math"
+	"time"
+)
+
+// A Calendar is an algorithm which determines the first day of each year of Persian calendar,
+// and therefore its leap years. The months of all calendars have the same lengths, except Esfand
+// which has 30 days in a leap year and 29 days otherwise.
+//
+// New, Date and the other functions which do not take a Calendar use ArithmeticCalendar, the *With
+// functions take a Calendar instead. A Time remembers the calendar by which it is created, so
+// implementations must be comparable. The calendar is not stored by the text, JSON, binary and SQL
+// encodings of Time, which use ArithmeticCalendar.
+type Calendar interface {
+	// YearStart returns the Julian Day Number of 1 Farvardin of year.
+	YearStart(year int) int
+}
+
+// ArithmeticCalendar is the 33-year arithmetic cycle, in which 8 years of each 33 years are leap years.
+// It agrees with AstronomicalCalendar for the years 1178 to 1502, see AstronomicalCalendar for the other years.
+type ArithmeticCalendar struct{}
+
+// BirashkCalendar is the 2820-year arithmetic cycle proposed by Ahmad Birashk,
+// in which 683 years of each 2820 years are leap years.
+type BirashkCalendar struct{}
+
+// AstronomicalCalendar is the official calendar of Iran, in which a year begins on the day of
+// the vernal equinox if it occurs before the true noon at the meridian of 52.5°E (Iran Standard Time),
+// otherwise on the next day.
+//
+// The moment of the equinox is computed with an error of about a minute around the present time,
+// which grows with the uncertainty of the rotation of the Earth far from it. Hence a year whose equinox
+// is within a few minutes of noon, like 1503, may begin on another day than in the official calendar.
+//
+// For the years 1000 to 1700 it agrees with ArithmeticCalendar, except that 1 Farvardin is a day later
+// in 1012, 1045, 1078 and 1177, and a day earlier in 1503, 1602, 1635 and 1668. Hence the calendars
+// disagree on whether these years and the years before them are leap years.
+type AstronomicalCalendar struct{}
+
+// defaultCalendar is the calendar of New, Date and the other functions which do not take a Calendar.
+var defaultCalendar Calendar = ArithmeticCalendar{}
+
+// persianEpoch is the Julian Day Number of the epoch of Persian calendar, i.e. 1 Farvardin 1 (March 19, 622 in Julian calendar).
+const persianEpoch = 1948321
+
+// meanTropicalYear is the mean length of the tropical year in days.
+const meanTropicalYear = 365.24219
+
+// YearStart returns the Julian Day Number of 1 Farvardin of year.
+func (ArithmeticCalendar) YearStart(year int) int {
+	return convertShamsiToJDN(year, int(Farvardin), 1)
+}
+
+// YearStart returns the Julian Day Number of 1 Farvardin of year.
+func (BirashkCalendar) YearStart(year int) int {
+	// The 2820-year cycle begins in 474, which is the year 0 of the cycle.
+	y := year - 474
+	cy := mod(y, 2820) + 474
+
+	return persianEpoch - 1 + 1029983*floorDiv(y, 2820) + 365*(cy-1) + floorDiv(31*cy-5, 128) + 1
+}
+
+// YearStart returns the Julian Day Number of 1 Farvardin of year.
+func (AstronomicalCalendar) YearStart(year int) int {
+	// The Julian Date of the vernal equinox in UT.
+	jd := equinoxJD(year+621, marchEquinox)
+
+	// The day of the equinox in Iran Standard Time.
+	jdn := int(math.Floor(jd + 0.5 + iranOffset))
+
+	// The true noon at 52.5°E, which is the mean noon of Iran Standard Time corrected by the equation of time.
+	noon := float64(jdn) - iranOffset - equationOfTime(jd)
+	if jd >= noon {
+		jdn++
+	}
+
+	return jdn
+}
+
+// NewWith converts Gregorian calendar to Persian calendar like New, using cal to determine the leap years.
+// If cal is nil then ArithmeticCalendar is used.
+func NewWith(t time.Time, cal Calendar) Time {
+	pt, err := NewStrictWith(t, cal)
+	if err != nil {
+		return Time{}
+	}
+
+	return pt
+}
+
+// DateWith returns a new instance of Time like Date, using cal to determine the leap years.
+// If cal is nil then ArithmeticCalendar is used.
+func DateWith(year int, month Month, day, hour, minute, sec, nsec int, loc *time.Location, cal Calendar) Time {
+	if loc == nil {
+		//nolint:gosmopolitan
+		loc = time.Local
+	}
+
+	t := Time{cal: cal}
+	t.Set(year, month, day, hour, minute, sec, nsec, loc)
+
+	if t.year < MinYear || t.year > MaxYear {
+		return Time{}
+	}
+
+	return t
+}
+
+// IsLeapWith returns true if year is a leap year in cal. If cal is nil then ArithmeticCalendar is used.
+func IsLeapWith(year int, cal Calendar) bool {
+	if cal == nil {
+		cal = defaultCalendar
+	}
+	return isLeapIn(cal, year)
+}
+
+// Calendar returns the calendar of t, which is the calendar by which t is created.
+func (t Time) Calendar() Calendar {
+	return t.calendar()
+}
+
+// WithCalendar returns a new instance of Time representing the same instant as t in cal,
+// i.e. the date of t is converted from the calendar of t to cal.
+// If cal is nil then ArithmeticCalendar is used.
+func (t Time) WithCalendar(cal Calendar) Time {
+	return NewWith(t.Time(), cal)
+}
+
+// calendar returns the calendar of t, or ArithmeticCalendar if t has none.
+func (t Time) calendar() Calendar {
+	if t.cal == nil {
+		return defaultCalendar
+	}
+	return t.cal
+}
+
+// date returns a new instance of Time like Date in the calendar of t.
+func (t Time) date(year int, month Month, day, hour, minute, sec, nsec int, loc *time.Location) Time {
+	return DateWith(year, month, day, hour, minute, sec, nsec, loc, t.calendar())
+}
+
+// newTime returns a new instance of Time like New in the calendar of t.
+func (t Time) newTime(ti time.Time) Time {
+	return NewWith(ti, t.calendar())
+}
+
+// isLeapIn reports whether year is a leap year in cal.
+func isLeapIn(cal Calendar, year int) bool {
+	if _, ok := cal.(ArithmeticCalendar); ok {
+		return divider(25*year+11, 33) < 8
+	}
+	return cal.YearStart(year+1)-cal.YearStart(year) == 366
+}
+
+// monthDaysIn returns the number of days of month in year in cal, month must be in the range [1, 12].
+func monthDaysIn(cal Calendar, year int, month Month) int {
+	if month == Esfand && isLeapIn(cal, year) {
+		return pMonthCount[month-1][1]
+	}
+	return pMonthCount[month-1][0]
+}
+
+// shamsiToJDN returns the Julian Day Number of a date in cal.
+func shamsiToJDN(cal Calendar, year, month, day int) int {
+	if _, ok := cal.(ArithmeticCalendar); ok {
+		return convertShamsiToJDN(year, month, day)
+	}
+	return cal.YearStart(year) + daysBeforeMonth(month) + day - 1
+}
+
+// jdnToShamsi returns the date of a Julian Day Number in cal.
+func jdnToShamsi(cal Calendar, jdn int) (int, int, int) {
+	if _, ok := cal.(ArithmeticCalendar); ok {
+		return convertJDNToShamsi(jdn)
+	}
+
+	year := int(math.Floor(float64(jdn-persianEpoch)/meanTropicalYear)) + 1
+	for cal.YearStart(year) > jdn {
+		year--
+	}
+	for cal.YearStart(year+1) <= jdn {
+		year++
+	}
+
+	month, day := monthDayOfYear(jdn - cal.YearStart(year))
+
+	return year, month, day
+}
+
+// daysBeforeMonth returns the number of days of a year before the first day of month.
+func daysBeforeMonth(month int) int {
+	if month < 7 {
+		return (month - 1) * 31
+	}
+	return (month-7)*30 + 186
+}
+
+// monthDayOfYear returns the month and day of the zero-based day of year.
+func monthDayOfYear(yday int) (int, int) {
+	if yday < 186 {
+		return 1 + yday/31, 1 + yday%31
+	}
+	return 7 + (yday-186)/30, 1 + (yday-186)%30
+}
+
+// floorDiv returns num divided by den rounded toward negative infinity.
+func floorDiv(num, den int) int {
+	q := num / den
+	if (num%den != 0) && ((num < 0) != (den < 0)) {
+		q--
+	}
+	return q
+}
@@ -0,0 +1,167 @@
+package ptime_test
+
+import (
+	"testing"
+	"time"
+
+	ptime "github.com/yaa110/go-persian-calendar"
+)
+
+func TestAstronomicalCalendar(t *testing.T) {
+	arithmetic := ptime.ArithmeticCalendar{}
+	astronomical := ptime.AstronomicalCalendar{}
+
+	// The differences of 1 Farvardin in AstronomicalCalendar from ArithmeticCalendar.
+	diffs := map[int]int{
+		1012: 1,
+		1045: 1,
+		1078: 1,
+		1177: 1,
+		1503: -1,
+		1602: -1,
+		1635: -1,
+		1668: -1,
+	}
+
+	for year := 1000; year <= 1700; year++ {
+		if a, b := arithmetic.YearStart(year), astronomical.YearStart(year); b-a != diffs[year] {
+			t.Error(
+				"For", year,
+				"expected", a+diffs[year],
+				"got", b,
+			)
+		}
+	}
+
+	if ptime.IsLeapWith(1177, astronomical) == ptime.IsLeapWith(1177, arithmetic) {
+		t.Error("Expected the calendars to differ in 1177")
+	}
+}
+
+func TestBirashkCalendar(t *testing.T) {
+	birashk := ptime.BirashkCalendar{}
+
+	leaps := map[int]bool{1399: true, 1403: false, 1404: true, 1408: true}
+	for year, leap := range leaps {
+		if ptime.IsLeapWith(year, birashk) != leap {
+			t.Error(
+				"For", year,
+				"expected", leap,
+				"got", !leap,
+			)
+		}
+	}
+
+	gt := time.Date(2025, time.March, 20, 12, 0, 0, 0, time.UTC)
+
+	if y, m, d := ptime.NewWith(gt, birashk).Date(); y != 1404 || m != ptime.Farvardin || d != 1 {
+		t.Error(
+			"Expected", 1404, ptime.Farvardin, 1,
+			"got", y, m, d,
+		)
+	}
+
+	if y, m, d := ptime.New(gt).Date(); y != 1403 || m != ptime.Esfand || d != 30 {
+		t.Error(
+			"Expected", 1403, ptime.Esfand, 30,
+			"got", y, m, d,
+		)
+	}
+
+	pt := ptime.DateWith(1404, ptime.Esfand, 30, 12, 0, 0, 0, time.UTC, birashk)
+	if want := time.Date(2026, time.March, 20, 12, 0, 0, 0, time.UTC); !pt.Time().Equal(want) {
+		t.Error(
+			"Expected", want,
+			"got", pt.Time(),
+		)
+	}
+}
+
+func TestWithCalendar(t *testing.T) {
+	pt := ptime.Date(1403, ptime.Esfand, 30, 12, 0, 0, 0, time.UTC)
+	bt := pt.WithCalendar(ptime.BirashkCalendar{})
+
+	if bt.Calendar() != (ptime.BirashkCalendar{}) || pt.Calendar() != (ptime.ArithmeticCalendar{}) {
+		t.Error(
+			"Expected", ptime.BirashkCalendar{}, ptime.ArithmeticCalendar{},
+			"got", bt.Calendar(), pt.Calendar(),
+		)
+	}
+
+	if !bt.Time().Equal(pt.Time()) || bt.Format("yyyy-MM-dd HH:mm") != "1404-01-01 12:00" {
+		t.Error(
+			"Expected", "1404-01-01 12:00",
+			"got", bt.Format("yyyy-MM-dd HH:mm"),
+		)
+	}
+
+	// The calendar is kept by the arithmetic.
+	if lt := bt.LastYearDay(); lt.Calendar() != (ptime.BirashkCalendar{}) || lt.Day() != 30 || !lt.IsLeap() {
+		t.Error(
+			"Expected", ptime.BirashkCalendar{}, 30, true,
+			"got", lt.Calendar(), lt.Day(), lt.IsLeap(),
+		)
+	}
+}
+
+func TestDefaultCalendar(t *testing.T) {
+	pt := ptime.Date(1404, ptime.Esfand, 30, 0, 0, 0, 0, time.UTC)
+	if pt.Calendar() != (ptime.ArithmeticCalendar{}) || pt.IsLeap() || pt.String() != "1405-01-01T00:00:00.0+00:00" {
+		t.Error(
+			"Expected", ptime.ArithmeticCalendar{}, false, "1405-01-01T00:00:00.0+00:00",
+			"got", pt.Calendar(), pt.IsLeap(), pt,
+		)
+	}
+
+	if !ptime.IsLeapWith(1403, nil) || ptime.IsLeapWith(1404, nil) {
+		t.Error("Expected", true, false, "got", ptime.IsLeapWith(1403, nil), ptime.IsLeapWith(1404, nil))
+	}
+}
+
+func TestCalendarPeriods(t *testing.T) {
+	// 1 Farvardin 1404 in BirashkCalendar is 30 Esfand 1403 in ArithmeticCalendar.
+	bt := ptime.DateWith(1404, ptime.Farvardin, 1, 12, 0, 0, 0, time.UTC, ptime.BirashkCalendar{})
+
+	if d := bt.CivilDate(); d != ptime.NewCivilDate(1403, ptime.Esfand, 30) {
+		t.Error(
+			"Expected", "1403-12-30",
+			"got", d,
+		)
+	}
+
+	m := ptime.NewYearMonth(1403, ptime.Esfand)
+	if bt.YearMonth() != m || !m.Contains(bt) || !ptime.Year(1403).Contains(bt) {
+		t.Error(
+			"Expected", m,
+			"got", bt.YearMonth(),
+		)
+	}
+
+	if bt.WeekYear() != 1404 || bt.Week() != 1 || bt.FormatWeekDate() != "1404-W01-6" {
+		t.Error(
+			"Expected", 1404, 1, "1404-W01-6",
+			"got", bt.WeekYear(), bt.Week(), bt.FormatWeekDate(),
+		)
+	}
+}
+
+func TestCalendarRoundTrip(t *testing.T) {
+	calendars := []ptime.Calendar{
+		ptime.ArithmeticCalendar{},
+		ptime.BirashkCalendar{},
+		ptime.AstronomicalCalendar{},
+	}
+
+	for _, cal := range calendars {
+		for i := 0; i < 20000; i += 7 {
+			pt := ptime.DateWith(1300, ptime.Farvardin, 1+i, 12, 0, 0, 0, time.UTC, cal)
+			if u := ptime.NewWith(pt.Time(), cal); u != pt {
+				t.Error(
+					"For", cal, i,
+					"expected", pt,
+					"got", u,
+				)
+			}
+		}
+	}
+}
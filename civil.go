@@ -0,0 +1,239 @@
+package ptime
+
+import (
+	"errors"
+	"time"
+)
+
+// civilLayout is the layout of CivilDate.String, which is used as the text encoding of CivilDate.
+const civilLayout = "yyyy-MM-dd"
+
+// A CivilDate represents a date in Persian calendar without a time of day or a location,
+// e.g. a birthday or a holiday. The leap years of CivilDate are determined by ArithmeticCalendar.
+//
+// CivilDate values are always normalized, so they can be compared with == and used as map keys.
+// The zero value of CivilDate is not a valid date and is reported by IsZero.
+type CivilDate struct {
+	year  int
+	month Month
+	day   int
+}
+
+// NewCivilDate returns a new instance of CivilDate.
+//
+// The month and day values may be outside their usual ranges and are normalized,
+// e.g. 31 Mehr is converted to 1 Aban.
+func NewCivilDate(year int, month Month, day int) CivilDate {
+	year, month, day = normDate(defaultCalendar, year, month, day)
+	return CivilDate{year, month, day}
+}
+
+// CivilDateOf returns the date of t in the location of t.
+// The date is converted to ArithmeticCalendar if t has another calendar.
+func CivilDateOf(t Time) CivilDate {
+	if t.calendar() != defaultCalendar {
+		return civilDateOfJDN(t.jdn())
+	}
+	return CivilDate{t.year, t.month, t.day}
+}
+
+// civilDateOfJDN returns the date of a Julian Day Number.
+func civilDateOfJDN(jdn int) CivilDate {
+	year, month, day := jdnToShamsi(defaultCalendar, jdn)
+	return CivilDate{year, Month(month), day}
+}
+
+// CivilDate returns the date of t in the location of t. See CivilDateOf.
+func (t Time) CivilDate() CivilDate {
+	return CivilDateOf(t)
+}
+
+// At returns a new instance of Time for the date of d at the given clock time in loc.
+// If loc is nil then the local time is used.
+func (d CivilDate) At(hour, minute, sec, nsec int, loc *time.Location) Time {
+	return Date(d.year, d.month, d.day, hour, minute, sec, nsec, loc)
+}
+
+// String returns the date in yyyy-MM-dd format, e.g. 1403-07-15.
+func (d CivilDate) String() string {
+	return d.Format(civilLayout)
+}
+
+// Format returns the formatted representation of d like Time.Format.
+// The tokens of the time of day and the zone are formatted as midnight in UTC.
+func (d CivilDate) Format(format string) string {
+	return d.FormatDigits(format, LatinDigits)
+}
+
+// FormatDigits returns the formatted representation of d like Format,
+// using the given digit system for the numeric fields.
+func (d CivilDate) FormatDigits(format string, digits Digits) string {
+	return d.At(0, 0, 0, 0, time.UTC).FormatDigits(format, digits)
+}
+
+// ParseCivilDate parses a formatted string like Parse and returns the date it represents.
+// Any time of day or zone in value is validated but otherwise ignored.
+func ParseCivilDate(layout, value string) (CivilDate, error) {
+	t, err := Parse(layout, value, time.UTC)
+	if err != nil {
+		return CivilDate{}, err
+	}
+
+	return CivilDateOf(t), nil
+}
+
+// Date returns the year, month and day of d.
+func (d CivilDate) Date() (int, Month, int) {
+	return d.year, d.month, d.day
+}
+
+// Year returns the year of d.
+func (d CivilDate) Year() int {
+	return d.year
+}
+
+// Month returns the month of d in the range [1, 12].
+func (d CivilDate) Month() Month {
+	return d.month
+}
+
+// Day returns the day of month of d.
+func (d CivilDate) Day() int {
+	return d.day
+}
+
+// Weekday returns the weekday of d.
+func (d CivilDate) Weekday() Weekday {
+	return jdnWeekday(d.jdn())
+}
+
+// YearDay returns the day of year of d.
+func (d CivilDate) YearDay() int {
+	if d.month < Farvardin {
+		return 0
+	}
+	return pMonthCount[d.month-1][2] + d.day
+}
+
+// IsLeap returns true if the year of d is a leap year.
+func (d CivilDate) IsLeap() bool {
+	return isLeap(d.year)
+}
+
+// IsZero returns true if d is the zero value of CivilDate.
+func (d CivilDate) IsZero() bool {
+	return d == CivilDate{}
+}
+
+// AddDate returns a new instance of CivilDate for d.year+years, d.month+months and d.day+days.
+// The result is normalized like AddDate of Time, e.g. 31 Shahrivar + 1 month is 1 Aban.
+func (d CivilDate) AddDate(years, months, days int) CivilDate {
+	return NewCivilDate(d.year+years, d.month+Month(months), d.day+days)
+}
+
+// AddDays returns a new instance of CivilDate representing n days after d.
+func (d CivilDate) AddDays(n int) CivilDate {
+	return civilDateOfJDN(d.jdn() + n)
+}
+
+// DaysSince returns the number of days from u to d, which is negative if d is before u.
+func (d CivilDate) DaysSince(u CivilDate) int {
+	return d.jdn() - u.jdn()
+}
+
+// Before reports whether d is before u.
+func (d CivilDate) Before(u CivilDate) bool {
+	return d.Compare(u) < 0
+}
+
+// After reports whether d is after u.
+func (d CivilDate) After(u CivilDate) bool {
+	return d.Compare(u) > 0
+}
+
+// Compare compares d with u. If d is before u, it returns -1;
+// if d is after u, it returns +1; if they're the same, it returns 0.
+func (d CivilDate) Compare(u CivilDate) int {
+	switch {
+	case d.year != u.year:
+		return compareInt(d.year, u.year)
+	case d.month != u.month:
+		return compareInt(int(d.month), int(u.month))
+	default:
+		return compareInt(d.day, u.day)
+	}
+}
+
+// MarshalText implements the encoding.TextMarshaler interface. The date is formatted as String does,
+// and the zero CivilDate is encoded as an empty text.
+func (d CivilDate) MarshalText() ([]byte, error) {
+	if d.IsZero() {
+		return []byte{}, nil
+	}
+
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface. The date must be in yyyy-MM-dd format.
+// An empty text is decoded as the zero CivilDate.
+func (d *CivilDate) UnmarshalText(data []byte) error {
+	if len(data) == 0 {
+		*d = CivilDate{}
+		return nil
+	}
+
+	pd, err := ParseCivilDate(civilLayout, string(data))
+	if err != nil {
+		return err
+	}
+
+	*d = pd
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// The date is encoded as a JSON string in the format of MarshalText, and the zero CivilDate as null.
+func (d CivilDate) MarshalJSON() ([]byte, error) {
+	if d.IsZero() {
+		return []byte("null"), nil
+	}
+
+	return []byte(`"` + d.String() + `"`), nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// The date must be a JSON string in the format of MarshalText. JSON null is a no-op.
+func (d *CivilDate) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+
+	if len(data) < 2 || data[0] != '"' || data[len(data)-1] != '"' {
+		return errors.New("ptime: CivilDate.UnmarshalJSON: input is not a JSON string")
+	}
+
+	return d.UnmarshalText(data[1 : len(data)-1])
+}
+
+// jdn returns the Julian Day Number of d.
+func (d CivilDate) jdn() int {
+	return shamsiToJDN(defaultCalendar, d.year, int(d.month), d.day)
+}
+
+// jdnWeekday returns the weekday of a Julian Day Number.
+func jdnWeekday(jdn int) Weekday {
+	// The Julian Day Number 0 is a Doshanbeh.
+	return Weekday(mod(jdn+2, 7))
+}
+
+// compareInt returns -1, 0 or +1 depending on whether a is less than, equal to or greater than b.
+func compareInt(a, b int) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
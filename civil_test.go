@@ -0,0 +1,184 @@
+package ptime_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	ptime "github.com/yaa110/go-persian-calendar"
+)
+
+func TestCivilDate(t *testing.T) {
+	d := ptime.NewCivilDate(1403, ptime.Mehr, 15)
+
+	if d != ptime.NewCivilDate(1403, ptime.Shahrivar, 46) {
+		t.Error(
+			"Expected", d,
+			"got", ptime.NewCivilDate(1403, ptime.Shahrivar, 46),
+		)
+	}
+
+	if d.String() != "1403-07-15" {
+		t.Error(
+			"Expected", "1403-07-15",
+			"got", d.String(),
+		)
+	}
+
+	if d.Weekday() != ptime.Yekshanbeh || d.YearDay() != 201 || !d.IsLeap() {
+		t.Error(
+			"Expected", ptime.Yekshanbeh, 201, true,
+			"got", d.Weekday(), d.YearDay(), d.IsLeap(),
+		)
+	}
+
+	holidays := map[ptime.CivilDate]string{
+		ptime.NewCivilDate(1403, ptime.Farvardin, 1): "Nowruz",
+	}
+
+	if holidays[ptime.Date(1403, ptime.Farvardin, 1, 23, 0, 0, 0, ptime.Iran()).CivilDate()] != "Nowruz" {
+		t.Error("Expected the date of a Time to be found in a map")
+	}
+}
+
+func TestCivilDateArithmetic(t *testing.T) {
+	d := ptime.NewCivilDate(1403, ptime.Shahrivar, 31)
+
+	vals := []struct {
+		d ptime.CivilDate
+		s string
+	}{
+		{d.AddDate(0, 1, 0), "1403-08-01"},
+		{d.AddDate(0, 0, 1), "1403-07-01"},
+		{d.AddDate(1, 6, 0), "1405-01-02"},
+		{d.AddDays(-365), "1402-06-31"},
+		{d.AddDays(1000), "1406-03-28"},
+	}
+
+	for _, v := range vals {
+		if v.d.String() != v.s {
+			t.Error(
+				"Expected", v.s,
+				"got", v.d,
+			)
+		}
+	}
+
+	u := ptime.NewCivilDate(1404, ptime.Farvardin, 1)
+	if n := u.DaysSince(d); n != 181 {
+		t.Error(
+			"Expected", 181,
+			"got", n,
+		)
+	}
+
+	if !d.Before(u) || d.After(u) || d.Compare(u) != -1 || u.Compare(d) != 1 || d.Compare(d) != 0 {
+		t.Error("Expected", d, "to be before", u)
+	}
+}
+
+func TestCivilDateTime(t *testing.T) {
+	d := ptime.NewCivilDate(1400, ptime.Farvardin, 2)
+
+	ti := d.At(9, 30, 0, 0, ptime.Iran())
+	if ti.String() != "1400-01-02T09:30:00.0+04:30" {
+		t.Error(
+			"Expected", "1400-01-02T09:30:00.0+04:30",
+			"got", ti,
+		)
+	}
+
+	// The date of a Time is in its location.
+	utc := ptime.New(ptime.Date(1400, ptime.Farvardin, 2, 2, 0, 0, 0, ptime.Iran()).Time().In(time.UTC))
+	if utc.CivilDate() != d.AddDays(-1) {
+		t.Error(
+			"Expected", d.AddDays(-1),
+			"got", utc.CivilDate(),
+		)
+	}
+}
+
+func TestCivilDateFormat(t *testing.T) {
+	d := ptime.NewCivilDate(1403, ptime.Mehr, 15)
+
+	if s := d.Format("E d MMM yyyy"); s != "یک‌شنبه 15 مهر 1403" {
+		t.Error(
+			"Expected", "یک‌شنبه 15 مهر 1403",
+			"got", s,
+		)
+	}
+
+	if s := d.FormatDigits("yyyy/MM/dd", ptime.PersianDigits); s != "۱۴۰۳/۰۷/۱۵" {
+		t.Error(
+			"Expected", "۱۴۰۳/۰۷/۱۵",
+			"got", s,
+		)
+	}
+
+	pd, err := ptime.ParseCivilDate("yyyy/MM/dd HH:mm", "1403/07/15 23:30")
+	if err != nil || pd != d {
+		t.Error(
+			"Expected", d,
+			"got", pd, err,
+		)
+	}
+
+	if _, err := ptime.ParseCivilDate("yyyy/MM/dd", "1403/12/31"); err == nil {
+		t.Error("Expected an error for 1403/12/31")
+	}
+
+	b, err := json.Marshal(map[string]ptime.CivilDate{"birthday": d})
+	if err != nil || string(b) != `{"birthday":"1403-07-15"}` {
+		t.Error(
+			"Expected", `{"birthday":"1403-07-15"}`,
+			"got", string(b), err,
+		)
+	}
+
+	var got map[string]ptime.CivilDate
+	if err := json.Unmarshal(b, &got); err != nil || got["birthday"] != d {
+		t.Error(
+			"Expected", d,
+			"got", got, err,
+		)
+	}
+}
+
+func TestCivilDateZero(t *testing.T) {
+	var d ptime.CivilDate
+
+	if text, err := d.MarshalText(); err != nil || len(text) != 0 {
+		t.Error(
+			"Expected", "an empty text",
+			"got", string(text), err,
+		)
+	}
+
+	got := ptime.NewCivilDate(1403, ptime.Mehr, 15)
+	if err := got.UnmarshalText(nil); err != nil || !got.IsZero() {
+		t.Error(
+			"Expected", d,
+			"got", got, err,
+		)
+	}
+
+	b, err := json.Marshal(struct{ D ptime.CivilDate }{d})
+	if err != nil || string(b) != `{"D":null}` {
+		t.Error(
+			"Expected", `{"D":null}`,
+			"got", string(b), err,
+		)
+	}
+
+	v := struct{ D ptime.CivilDate }{ptime.NewCivilDate(1403, ptime.Mehr, 15)}
+	if err := json.Unmarshal(b, &v); err != nil || v.D != ptime.NewCivilDate(1403, ptime.Mehr, 15) {
+		t.Error(
+			"Expected", "1403-07-15",
+			"got", v.D, err,
+		)
+	}
+
+	if err := json.Unmarshal([]byte(`{"D":1403}`), &v); err == nil {
+		t.Error("Expected an error for 1403")
+	}
+}
@@ -1,13 +1,8 @@
 package ptime
 
-// gregorianReformJulianDay shows October 15, 1582.
+// gregorianReformJulianDay is the Julian Day Number of October 4, 1582 in Julian calendar, the last day before the Gregorian reform.
 const gregorianReformJulianDay = 2299160
 
-// isAfterGregorianReform checks if the testDate is after the Gregorian calendar reform (October 15, 1582).
-func isAfterGregorianReform(year, month, day int) bool {
-	return year > 1582 || (year == 1582 && month > 10) || (year == 1582 && month == 10 && day > 14)
-}
-
 // convertGregorianPostReformToJDN calculates the Julian Day Number (JDN) for dates after the Gregorian reform.
 // This function is based on the standard algorithm for converting a Gregorian calendar testDate into a Julian Day Number.
 // The Gregorian reform was implemented on October 15, 1582, which corrected the drift of the Julian calendar by modifying
@@ -38,12 +33,12 @@ func convertGregorianPostReformToJDN(year, month, day int) int {
 	)
 
 	adjustedYear := year + yearOffset + ((month - 14) / 12)
-	leapYearFactor := (daysInFourYearCycle * adjustedYear) / 4
+	leapYearFactor := floorDiv(daysInFourYearCycle*adjustedYear, 4)
 
 	adjustedMonth := month - 2 - 12*((month-14)/12)
 	monthFactor := (monthCycleFactor * adjustedMonth) / 12
 
-	centuryFactor := (3 * ((year + centuryAdjustmentOffset + ((month - 14) / 12)) / 100)) / 4
+	centuryFactor := floorDiv(3*floorDiv(year+centuryAdjustmentOffset+((month-14)/12), 100), 4)
 
 	return leapYearFactor + monthFactor - centuryFactor + day - baseDayAdjustment
 }
@@ -63,7 +58,7 @@ func convertGregorianPostReformToJDN(year, month, day int) int {
 // https://aa.usno.navy.mil/faq/JD_formula
 func convertGregorianPreReformToJDN(year, month, day int) int {
 	adjustedYear := year + 5001 + (month-9)/7
-	leapYearFactor := (7 * adjustedYear) / 4
+	leapYearFactor := floorDiv(7*adjustedYear, 4)
 
 	monthFactor := (275 * month) / 9
 
@@ -95,9 +90,9 @@ func convertJDNToGregorianPostReform(jdn int) (year, month, day int) {
 	offsetJDN := jdn + julianDayOffset
 
 	// Calculate century
-	century := 4 * offsetJDN / julianDayOf400Years
+	century := floorDiv(4*offsetJDN, julianDayOf400Years)
 	//nolint:gocritic
-	offsetJDN = offsetJDN - (julianDayOf400Years*century+3)/4
+	offsetJDN = offsetJDN - floorDiv(julianDayOf400Years*century+3, 4)
 
 	// Calculate year
 	yearBase := 4000 * (offsetJDN + 1) / julianDay4000YearCycleDayOffset
@@ -126,7 +121,7 @@ func convertJDNToGregorianPreReform(jdn int) (year, month, day int) {
 	offsetJDN := jdn + julianDayOffset
 
 	// Calculate year
-	quadrennialCycle := (offsetJDN - 1) / daysInFourYearCycle
+	quadrennialCycle := floorDiv(offsetJDN-1, daysInFourYearCycle)
 	remainingDays := offsetJDN - daysInFourYearCycle*quadrennialCycle
 	yearAdjustment := (remainingDays-1)/365 - remainingDays/daysInFourYearCycle
 	dayOfYear := remainingDays - 365*yearAdjustment + 30
@@ -167,9 +162,9 @@ func convertJDNToShamsi(jdn int) (year, month, day int) {
 	daysSinceStartOfShamsi := jdn - julianDayToShamsiOffset
 
 	// Calculate the Shamsi year
-	cyclesOf33Years := daysSinceStartOfShamsi / cyclesOf33YearsCount
+	cyclesOf33Years := floorDiv(daysSinceStartOfShamsi, cyclesOf33YearsCount)
 	year = -1595 + 33*cyclesOf33Years
-	remainingDays := daysSinceStartOfShamsi % cyclesOf33YearsCount
+	remainingDays := mod(daysSinceStartOfShamsi, cyclesOf33YearsCount)
 
 	cyclesOf4Years := remainingDays / daysInFourYearCycle
 	year += 4 * cyclesOf4Years
@@ -209,8 +204,8 @@ func convertShamsiToJDN(year, month, day int) int {
 	adjustedShamsiYear := year + 1595
 
 	// Calculate the number of leap years that have occurred up to the given year
-	leapYearContributionCount := (adjustedShamsiYear/leapYearCycle)*leapYearContribution +
-		((adjustedShamsiYear%leapYearCycle + 3) / 4)
+	leapYearContributionCount := floorDiv(adjustedShamsiYear, leapYearCycle)*leapYearContribution +
+		((mod(adjustedShamsiYear, leapYearCycle) + 3) / 4)
 
 	// Determine the day of the year within the Shamsi calendar
 	var dayOfYear int
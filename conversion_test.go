@@ -246,3 +246,25 @@ var julianToGregorianMapping = []struct {
 		gregorianDate: testDate{year: 2024, month: 7, day: 1},
 	},
 }
+
+func TestConversionRoundTrip(t *testing.T) {
+	// From before the Julian Period to the far future, including the negative Persian years.
+	for jdn := -1000000; jdn < 4000000; jdn += 97 {
+		if y, m, d := convertJDNToShamsi(jdn); convertShamsiToJDN(y, m, d) != jdn {
+			t.Errorf("Test failed for JDN %d: Shamsi %d-%d-%d does not round trip\n", jdn, y, m, d)
+		}
+
+		if y, m, d := convertJDNToGregorianPostReform(jdn); convertGregorianPostReformToJDN(y, m, d) != jdn {
+			t.Errorf("Test failed for JDN %d: Gregorian %d-%d-%d does not round trip\n", jdn, y, m, d)
+		}
+
+		if y, m, d := convertJDNToGregorianPreReform(jdn); convertGregorianPreReformToJDN(y, m, d) != jdn {
+			t.Errorf("Test failed for JDN %d: Julian %d-%d-%d does not round trip\n", jdn, y, m, d)
+		}
+	}
+
+	// The beginning of the Julian Period is January 1, 4713 BC (year -4712) in Julian calendar.
+	if jdn := convertGregorianPreReformToJDN(-4712, 1, 1); jdn != 0 {
+		t.Errorf("Test failed for -4712-1-1: expected JDN 0, got %d\n", jdn)
+	}
+}
@@ -0,0 +1,59 @@
+package ptime
+
+import "time"
+
+// A DateDiff is the difference between two times in Persian calendar units.
+// All fields of a DateDiff have the same sign.
+type DateDiff struct {
+	Years    int
+	Months   int
+	Days     int
+	Duration time.Duration // the remaining clock duration, which is less than a day
+}
+
+// Diff returns the difference between t and u in years, months, days and the remaining duration,
+// measured in Persian calendar in the location of t. It is consistent with AddDate, so that
+//
+//	t.AddDate(d.Years, d.Months, d.Days).Add(d.Duration)
+//
+// is equal to u. The fields are negative if u is before t.
+func (t Time) Diff(u Time) DateDiff {
+	loc := t.Time().Location()
+	u = t.newTime(u.Time().In(loc))
+
+	forward := !u.Before(t)
+
+	// passed reports whether a has gone past u in the direction of the difference.
+	passed := func(a Time) bool {
+		if forward {
+			return a.After(u)
+		}
+		return a.Before(u)
+	}
+
+	step := 1
+	if !forward {
+		step = -1
+	}
+
+	months := (u.year-t.year)*12 + int(u.month-t.month)
+	a := t.AddDate(0, months, 0)
+	for passed(a) {
+		months -= step
+		a = t.AddDate(0, months, 0)
+	}
+
+	days := u.jdn() - a.jdn()
+	b := a.AddDate(0, 0, days)
+	for passed(b) {
+		days -= step
+		b = a.AddDate(0, 0, days)
+	}
+
+	return DateDiff{
+		Years:    months / 12,
+		Months:   months % 12,
+		Days:     days,
+		Duration: u.Sub(b),
+	}
+}
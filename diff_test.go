@@ -0,0 +1,84 @@
+package ptime_test
+
+import (
+	"testing"
+	"time"
+
+	ptime "github.com/yaa110/go-persian-calendar"
+)
+
+func TestDiff(t *testing.T) {
+	tests := []struct {
+		t1, t2 ptime.Time
+		diff   ptime.DateDiff
+	}{
+		{
+			ptime.Date(1400, ptime.Mehr, 15, 8, 0, 0, 0, ptime.Iran()),
+			ptime.Date(1402, ptime.Dey, 20, 8, 0, 0, 0, ptime.Iran()),
+			ptime.DateDiff{Years: 2, Months: 3, Days: 5},
+		},
+		{
+			ptime.Date(1402, ptime.Dey, 20, 8, 0, 0, 0, ptime.Iran()),
+			ptime.Date(1400, ptime.Mehr, 15, 8, 0, 0, 0, ptime.Iran()),
+			ptime.DateDiff{Years: -2, Months: -3, Days: -5},
+		},
+		{
+			ptime.Date(1403, ptime.Shahrivar, 31, 12, 0, 0, 0, ptime.Iran()),
+			ptime.Date(1403, ptime.Mehr, 30, 12, 0, 0, 0, ptime.Iran()),
+			ptime.DateDiff{Days: 30},
+		},
+		{
+			ptime.Date(1403, ptime.Farvardin, 1, 12, 0, 0, 0, ptime.Iran()),
+			ptime.Date(1403, ptime.Ordibehesht, 1, 10, 30, 0, 0, ptime.Iran()),
+			ptime.DateDiff{Days: 30, Duration: 22*time.Hour + 30*time.Minute},
+		},
+		{
+			ptime.Date(1403, ptime.Esfand, 30, 0, 0, 0, 0, ptime.Iran()),
+			ptime.Date(1404, ptime.Farvardin, 1, 0, 0, 0, 0, ptime.Iran()),
+			ptime.DateDiff{Days: 1},
+		},
+		{
+			ptime.Date(1399, ptime.Esfand, 30, 0, 0, 0, 0, ptime.Iran()),
+			ptime.Date(1403, ptime.Esfand, 30, 0, 0, 0, 0, ptime.Iran()),
+			ptime.DateDiff{Years: 4},
+		},
+	}
+
+	for _, tt := range tests {
+		d := tt.t1.Diff(tt.t2)
+		if d != tt.diff {
+			t.Error(
+				"For", tt.t1, tt.t2,
+				"expected", tt.diff,
+				"got", d,
+			)
+		}
+	}
+}
+
+func TestDiffAddDate(t *testing.T) {
+	start := ptime.Date(1399, ptime.Bahman, 17, 9, 15, 0, 0, ptime.Iran())
+
+	for i := 0; i < 1200; i += 7 {
+		t1 := start.AddDate(0, 0, i)
+
+		for j := 0; j < 900; j += 29 {
+			t2 := start.AddDate(0, 0, j).Add(time.Duration(j) * time.Minute)
+
+			for _, pair := range [][2]ptime.Time{{t1, t2}, {t2, t1}} {
+				d := pair[0].Diff(pair[1])
+				if got := pair[0].AddDate(d.Years, d.Months, d.Days).Add(d.Duration); !got.Equal(pair[1]) {
+					t.Fatal(
+						"For", pair[0], pair[1],
+						"expected", pair[1],
+						"got", got, d,
+					)
+				}
+
+				if d.Duration >= 24*time.Hour || d.Duration <= -24*time.Hour || d.Months >= 12 || d.Months <= -12 {
+					t.Fatal("For", pair[0], pair[1], "got", d)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,71 @@
+package ptime
+
+import "unicode/utf8"
+
+// A Digits specifies the digit system of the numeric fields in formatted times.
+type Digits int
+
+// List of digit systems.
+const (
+	LatinDigits   Digits = iota // 0123456789
+	PersianDigits               // ۰۱۲۳۴۵۶۷۸۹ (U+06F0 to U+06F9)
+	ArabicDigits                // ٠١٢٣٤٥٦٧٨٩ (U+0660 to U+0669)
+)
+
+// zero returns the zero digit of d.
+func (d Digits) zero() rune {
+	switch d {
+	case PersianDigits:
+		return '۰'
+	case ArabicDigits:
+		return '٠'
+	default:
+		return '0'
+	}
+}
+
+// convert returns s with its ASCII digits replaced by the digits of d.
+func (d Digits) convert(s string) string {
+	zero := d.zero()
+	if zero == '0' {
+		return s
+	}
+
+	b := make([]byte, 0, 2*len(s))
+	for i := 0; i < len(s); i++ {
+		if isDigit(s[i]) {
+			b = utf8.AppendRune(b, zero+rune(s[i]-'0'))
+		} else {
+			b = append(b, s[i])
+		}
+	}
+
+	return string(b)
+}
+
+// digitAt returns the value of the digit at the beginning of s in any of the digit systems
+// and its length in bytes. The value is -1 if s does not begin with a digit.
+func digitAt(s string) (int, int) {
+	if s == "" {
+		return -1, 0
+	}
+
+	if isDigit(s[0]) {
+		return int(s[0] - '0'), 1
+	}
+
+	r, n := utf8.DecodeRuneInString(s)
+	switch {
+	case r >= '۰' && r <= '۹':
+		return int(r - '۰'), n
+	case r >= '٠' && r <= '٩':
+		return int(r - '٠'), n
+	}
+
+	return -1, 0
+}
+
+// isDigit reports whether c is an ASCII decimal digit.
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
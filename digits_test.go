@@ -0,0 +1,82 @@
+package ptime_test
+
+import (
+	"testing"
+
+	ptime "github.com/yaa110/go-persian-calendar"
+)
+
+func TestFormatDigits(t *testing.T) {
+	ti := ptime.Date(1394, ptime.Mehr, 2, 14, 7, 8, 52065090, ptime.Iran())
+
+	vals := []struct {
+		layout string
+		digits ptime.Digits
+		s      string
+	}{
+		{"yyyy/MM/dd", ptime.LatinDigits, "1394/07/02"},
+		{"yyyy/MM/dd", ptime.PersianDigits, "۱۳۹۴/۰۷/۰۲"},
+		{"yyyy/MM/dd", ptime.ArabicDigits, "١٣٩٤/٠٧/٠٢"},
+		{"d MMM yy, HH:mm:ss.S Z", ptime.PersianDigits, "۲ مهر ۹۴, ۱۴:۰۷:۰۸.۰۵۲ +03:30"},
+		{"هفته w - 2023", ptime.PersianDigits, "هفته ۲۷ - 2023"},
+	}
+
+	for _, v := range vals {
+		if s := ti.FormatDigits(v.layout, v.digits); s != v.s {
+			t.Error(
+				"Expected", v.s,
+				"got", s,
+			)
+		}
+	}
+
+	if s := ti.TimeFormatDigits("2 Jan 2006 15:04:05.000 -07:00", ptime.PersianDigits); s != "۲ مهر ۱۳۹۴ ۱۴:۰۷:۰۸.۰۵۲ +03:30" {
+		t.Error(
+			"Expected", "۲ مهر ۱۳۹۴ ۱۴:۰۷:۰۸.۰۵۲ +03:30",
+			"got", s,
+		)
+	}
+
+	if s := ti.TimeFormatDigits("_2/01", ptime.ArabicDigits); s != " ٢/٠٧" {
+		t.Error(
+			"Expected", " ٢/٠٧",
+			"got", s,
+		)
+	}
+}
+
+func TestParseDigits(t *testing.T) {
+	ti := ptime.Date(1394, ptime.Mehr, 2, 14, 7, 8, 52065090, ptime.Iran())
+
+	for _, digits := range []ptime.Digits{ptime.LatinDigits, ptime.PersianDigits, ptime.ArabicDigits} {
+		layout := "yyyy/MM/dd HH:mm:ss.ns Z"
+
+		pt, err := ptime.Parse(layout, ti.FormatDigits(layout, digits), ptime.Iran())
+		if err != nil || !pt.Equal(ti) {
+			t.Error(
+				"For", ti.FormatDigits(layout, digits),
+				"expected", ti,
+				"got", pt, err,
+			)
+		}
+
+		layout = "2006/01/02 15:04:05.999999999 -07:00"
+
+		pt, err = ptime.ParseTimeFormat(layout, ti.TimeFormatDigits(layout, digits), ptime.Iran())
+		if err != nil || !pt.Equal(ti) {
+			t.Error(
+				"For", ti.TimeFormatDigits(layout, digits),
+				"expected", ti,
+				"got", pt, err,
+			)
+		}
+	}
+
+	pt, err := ptime.Parse("yyyy/M/d", "۱۴۰۳/1/٥", ptime.Iran())
+	if err != nil || pt.Year() != 1403 || pt.Month() != ptime.Farvardin || pt.Day() != 5 {
+		t.Error(
+			"Expected", "1403/1/5",
+			"got", pt, err,
+		)
+	}
+}
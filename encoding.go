@@ -0,0 +1,186 @@
+package ptime
+
+import (
+	"encoding/binary"
+	"errors"
+	"strings"
+	"time"
+)
+
+// textLayout is the layout of String, which is used as the text encoding of Time.
+const textLayout = "yyyy-MM-ddTHH:mm:ss.nsZ"
+
+// binaryVersion is the version of the binary encoding of Time.
+const binaryVersion byte = 1
+
+// binaryHeaderLen is the length of the binary encoding of Time without the location name:
+// version (1), unix seconds (8), nanoseconds (4), zone offset in seconds (4) and length of the location name (1).
+const binaryHeaderLen = 18
+
+// MarshalText implements the encoding.TextMarshaler interface.
+//
+// The time is formatted as String does and followed by the name of its location
+// in square brackets, e.g. 1394-07-02T14:07:08.52065090+03:30[Asia/Tehran].
+// The location name is omitted if it is empty or Local. The zero Time is encoded as an empty text.
+func (t Time) MarshalText() ([]byte, error) {
+	if t.IsZero() {
+		return []byte{}, nil
+	}
+
+	s := t.String()
+	if name := t.loc.String(); name != "" && name != "Local" {
+		s += "[" + name + "]"
+	}
+
+	return []byte(s), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+//
+// The text must be in the format of MarshalText. If the location name is omitted,
+// the local time is used if it has the same zone offset, otherwise a fixed zone is used.
+// If the location name is not known, a fixed zone with that name is used.
+// An empty text is decoded as the zero Time.
+func (t *Time) UnmarshalText(data []byte) error {
+	s := string(data)
+	if s == "" {
+		*t = Time{}
+		return nil
+	}
+
+	var name string
+	if i := strings.IndexByte(s, '['); i >= 0 && strings.HasSuffix(s, "]") {
+		s, name = s[:i], s[i+1:len(s)-1]
+	}
+
+	var loc *time.Location
+	if name != "" {
+		loc, _ = loadLocation(name, nil)
+	}
+
+	pt, err := Parse(textLayout, s, loc)
+	if err != nil {
+		return err
+	}
+
+	if name != "" && loc == nil {
+		_, offset := pt.Zone()
+		pt = New(pt.Time().In(time.FixedZone(name, offset)))
+	}
+
+	*t = pt
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// The time is encoded as a JSON string in the format of MarshalText, and the zero Time as null.
+func (t Time) MarshalJSON() ([]byte, error) {
+	if t.IsZero() {
+		return []byte("null"), nil
+	}
+
+	text, err := t.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+
+	b := make([]byte, 0, len(text)+2)
+	b = append(b, '"')
+	b = append(b, text...)
+	b = append(b, '"')
+
+	return b, nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// The time must be a JSON string in the format of MarshalText. JSON null is a no-op.
+func (t *Time) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+
+	if len(data) < 2 || data[0] != '"' || data[len(data)-1] != '"' {
+		return errors.New("ptime: Time.UnmarshalJSON: input is not a JSON string")
+	}
+
+	return t.UnmarshalText(data[1 : len(data)-1])
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.
+//
+// The time is encoded as its instant and zone offset, followed by the name of its location
+// which is empty for fixed zones without a name. The zero Time is encoded as the version byte only.
+func (t Time) MarshalBinary() ([]byte, error) {
+	if t.IsZero() {
+		return []byte{binaryVersion}, nil
+	}
+
+	name := t.loc.String()
+	if len(name) > 255 {
+		return nil, errors.New("ptime: Time.MarshalBinary: location name is too long")
+	}
+
+	gt := t.Time()
+	_, offset := gt.Zone()
+
+	b := make([]byte, binaryHeaderLen, binaryHeaderLen+len(name))
+	b[0] = binaryVersion
+	binary.BigEndian.PutUint64(b[1:], uint64(gt.Unix()))
+	binary.BigEndian.PutUint32(b[9:], uint32(gt.Nanosecond()))
+	binary.BigEndian.PutUint32(b[13:], uint32(int32(offset)))
+	b[17] = byte(len(name))
+
+	return append(b, name...), nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+//
+// The location is loaded by its name. If the name is empty or not known,
+// or the location has another zone offset at that time, a fixed zone is used.
+func (t *Time) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		return errors.New("ptime: Time.UnmarshalBinary: no data")
+	}
+
+	if data[0] != binaryVersion {
+		return errors.New("ptime: Time.UnmarshalBinary: unsupported version")
+	}
+
+	if len(data) == 1 {
+		*t = Time{}
+		return nil
+	}
+
+	if len(data) < binaryHeaderLen || len(data) != binaryHeaderLen+int(data[17]) {
+		return errors.New("ptime: Time.UnmarshalBinary: invalid length")
+	}
+
+	sec := int64(binary.BigEndian.Uint64(data[1:]))
+	nsec := int64(binary.BigEndian.Uint32(data[9:]))
+	offset := int(int32(binary.BigEndian.Uint32(data[13:])))
+	name := string(data[binaryHeaderLen:])
+
+	gt := time.Unix(sec, nsec)
+
+	loc := time.FixedZone(name, offset)
+	if name != "" {
+		if l, err := loadLocation(name, nil); err == nil {
+			if _, o := gt.In(l).Zone(); o == offset {
+				loc = l
+			}
+		}
+	}
+
+	*t = New(gt.In(loc))
+	return nil
+}
+
+// GobEncode implements the gob.GobEncoder interface.
+func (t Time) GobEncode() ([]byte, error) {
+	return t.MarshalBinary()
+}
+
+// GobDecode implements the gob.GobDecoder interface.
+func (t *Time) GobDecode(data []byte) error {
+	return t.UnmarshalBinary(data)
+}
@@ -0,0 +1,229 @@
+package ptime_test
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"testing"
+	"time"
+
+	ptime "github.com/yaa110/go-persian-calendar"
+)
+
+type jsonEvent struct {
+	Name string     `json:"name"`
+	At   ptime.Time `json:"at"`
+}
+
+func TestMarshalText(t *testing.T) {
+	times := []ptime.Time{
+		ptime.Date(1394, ptime.Mehr, 2, 14, 7, 8, 52065090, ptime.Iran()),
+		ptime.Date(1402, ptime.Esfand, 29, 0, 0, 0, 0, ptime.Afghanistan()),
+		ptime.Date(1403, ptime.Tir, 1, 23, 59, 59, 999999999, time.UTC),
+		ptime.Date(1403, ptime.Tir, 1, 12, 0, 0, 0, time.FixedZone("", -12600)),
+		ptime.Date(1403, ptime.Tir, 1, 12, 0, 0, 0, time.FixedZone("XYZ", 7200)),
+	}
+
+	for _, ti := range times {
+		text, err := ti.MarshalText()
+		if err != nil {
+			t.Error("For", ti, "unexpected error", err)
+			continue
+		}
+
+		var pt ptime.Time
+		if err := pt.UnmarshalText(text); err != nil {
+			t.Error("For", string(text), "unexpected error", err)
+			continue
+		}
+
+		if !pt.Equal(ti) || pt.String() != ti.String() || pt.Location().String() != ti.Location().String() {
+			t.Error(
+				"For", string(text),
+				"expected", ti, ti.Location(),
+				"got", pt, pt.Location(),
+			)
+		}
+	}
+
+	text, _ := times[0].MarshalText()
+	if string(text) != "1394-07-02T14:07:08.52065090+03:30[Asia/Tehran]" {
+		t.Error(
+			"Expected", "1394-07-02T14:07:08.52065090+03:30[Asia/Tehran]",
+			"got", string(text),
+		)
+	}
+}
+
+func TestMarshalJSON(t *testing.T) {
+	ev := jsonEvent{"nowruz", ptime.Date(1403, ptime.Farvardin, 1, 6, 36, 26, 0, ptime.Iran())}
+
+	b, err := json.Marshal(ev)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(b) != `{"name":"nowruz","at":"1403-01-01T06:36:26.0+03:30[Asia/Tehran]"}` {
+		t.Error(
+			"Expected", `{"name":"nowruz","at":"1403-01-01T06:36:26.0+03:30[Asia/Tehran]"}`,
+			"got", string(b),
+		)
+	}
+
+	var got jsonEvent
+	if err := json.Unmarshal(b, &got); err != nil || !got.At.Equal(ev.At) {
+		t.Error(
+			"Expected", ev.At,
+			"got", got.At, err,
+		)
+	}
+
+	b, _ = json.Marshal(jsonEvent{Name: "zero"})
+	if string(b) != `{"name":"zero","at":null}` {
+		t.Error(
+			"Expected", `{"name":"zero","at":null}`,
+			"got", string(b),
+		)
+	}
+
+	got = jsonEvent{}
+	if err := json.Unmarshal([]byte(`{"name":"zero","at":null}`), &got); err != nil || !got.At.IsZero() {
+		t.Error(
+			"Expected", "zero time",
+			"got", got.At, err,
+		)
+	}
+
+	if err := json.Unmarshal([]byte(`{"at":1394}`), &got); err == nil {
+		t.Error("Expected an error for a JSON number")
+	}
+
+	if err := json.Unmarshal([]byte(`{"at":"1394/07/02"}`), &got); err == nil {
+		t.Error("Expected an error for an invalid time")
+	}
+}
+
+func TestMarshalBinary(t *testing.T) {
+	times := []ptime.Time{
+		ptime.Date(1394, ptime.Mehr, 2, 14, 7, 8, 52065090, ptime.Iran()),
+		ptime.Date(1402, ptime.Esfand, 29, 0, 0, 0, 0, ptime.Afghanistan()),
+		ptime.Date(1403, ptime.Tir, 1, 23, 59, 59, 999999999, time.UTC),
+		ptime.Date(1403, ptime.Tir, 1, 12, 0, 0, 0, time.FixedZone("", -12600)),
+		ptime.Date(1403, ptime.Tir, 1, 12, 0, 0, 0, time.FixedZone("XYZ", 7200)),
+		{},
+	}
+
+	for _, ti := range times {
+		b, err := ti.MarshalBinary()
+		if err != nil {
+			t.Error("For", ti, "unexpected error", err)
+			continue
+		}
+
+		var pt ptime.Time
+		if err := pt.UnmarshalBinary(b); err != nil {
+			t.Error("For", ti, "unexpected error", err)
+			continue
+		}
+
+		if pt != ti && (pt.String() != ti.String() || pt.Weekday() != ti.Weekday() ||
+			pt.Location().String() != ti.Location().String()) {
+			t.Error(
+				"Expected", ti, ti.Weekday(), ti.Location(),
+				"got", pt, pt.Weekday(), pt.Location(),
+			)
+		}
+	}
+
+	invalid := [][]byte{
+		nil,
+		{2},
+		{1, 0, 0},
+		append(must(times[0].MarshalBinary()), 'x'),
+	}
+
+	for _, b := range invalid {
+		var pt ptime.Time
+		if err := pt.UnmarshalBinary(b); err == nil {
+			t.Error("Expected an error for", b)
+		}
+	}
+}
+
+func TestGob(t *testing.T) {
+	times := []ptime.Time{
+		ptime.Date(1394, ptime.Mehr, 2, 14, 7, 8, 52065090, ptime.Iran()),
+		ptime.Date(1403, ptime.Tir, 1, 12, 0, 0, 0, time.UTC),
+	}
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(times); err != nil {
+		t.Fatal(err)
+	}
+
+	var got []ptime.Time
+	if err := gob.NewDecoder(&buf).Decode(&got); err != nil {
+		t.Fatal(err)
+	}
+
+	for i := range times {
+		if got[i].String() != times[i].String() || got[i].Location().String() != times[i].Location().String() {
+			t.Error(
+				"Expected", times[i],
+				"got", got[i],
+			)
+		}
+	}
+}
+
+func must(b []byte, err error) []byte {
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+func TestMarshalYearRange(t *testing.T) {
+	years := []int{ptime.MinYear, -5, 999, 12000, ptime.MaxYear}
+
+	for _, year := range years {
+		pt := ptime.Date(year, ptime.Esfand, 29, 23, 59, 59, 0, time.UTC)
+
+		text, err := pt.MarshalText()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var u ptime.Time
+		if err := u.UnmarshalText(text); err != nil || u != pt {
+			t.Error(
+				"For", string(text),
+				"expected", pt,
+				"got", u, err,
+			)
+		}
+
+		b, err := json.Marshal(pt)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		u = ptime.Time{}
+		if err := json.Unmarshal(b, &u); err != nil || u != pt {
+			t.Error(
+				"For", string(b),
+				"expected", pt,
+				"got", u, err,
+			)
+		}
+
+		u = ptime.Time{}
+		if err := u.Scan(string(text)); err != nil || u != pt {
+			t.Error(
+				"For", string(text),
+				"expected", pt,
+				"got", u, err,
+			)
+		}
+	}
+}
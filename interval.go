@@ -0,0 +1,108 @@
+package ptime
+
+import "time"
+
+// An Interval represents the half-open range of time [Start, End).
+// An Interval whose End is not after its Start is empty.
+type Interval struct {
+	Start Time
+	End   Time
+}
+
+// NewInterval returns a new instance of Interval from start to end.
+// If end is before start, they are swapped.
+func NewInterval(start, end Time) Interval {
+	if end.Before(start) {
+		start, end = end, start
+	}
+	return Interval{start, end}
+}
+
+// String returns the interval in the format of String of its start and end separated by a slash,
+// e.g. 1403-01-01T00:00:00.0+03:30/1403-02-01T00:00:00.0+03:30.
+func (iv Interval) String() string {
+	return iv.Start.String() + "/" + iv.End.String()
+}
+
+// IsEmpty reports whether iv contains no instant.
+func (iv Interval) IsEmpty() bool {
+	return !iv.Start.Before(iv.End)
+}
+
+// Duration returns the elapsed time from the start to the end of iv, which is zero if iv is empty.
+func (iv Interval) Duration() time.Duration {
+	if iv.IsEmpty() {
+		return 0
+	}
+	return iv.End.Sub(iv.Start)
+}
+
+// Contains reports whether the instant t is in iv, i.e. Start <= t < End.
+func (iv Interval) Contains(t Time) bool {
+	return !t.Before(iv.Start) && t.Before(iv.End)
+}
+
+// Overlaps reports whether iv and u have an instant in common.
+func (iv Interval) Overlaps(u Interval) bool {
+	return !iv.IsEmpty() && !u.IsEmpty() && iv.Start.Before(u.End) && u.Start.Before(iv.End)
+}
+
+// Intersect returns the common part of iv and u. The result is false if they do not overlap.
+func (iv Interval) Intersect(u Interval) (Interval, bool) {
+	if !iv.Overlaps(u) {
+		return Interval{}, false
+	}
+
+	res := iv
+	if u.Start.After(res.Start) {
+		res.Start = u.Start
+	}
+	if u.End.Before(res.End) {
+		res.End = u.End
+	}
+
+	return res, true
+}
+
+// Union returns the interval which covers both iv and u. The result is false if they
+// neither overlap nor are adjacent, since the union would not be a single interval.
+// An empty interval is ignored.
+func (iv Interval) Union(u Interval) (Interval, bool) {
+	switch {
+	case u.IsEmpty():
+		return iv, true
+	case iv.IsEmpty():
+		return u, true
+	case iv.Start.After(u.End) || u.Start.After(iv.End):
+		return Interval{}, false
+	}
+
+	res := iv
+	if u.Start.Before(res.Start) {
+		res.Start = u.Start
+	}
+	if u.End.After(res.End) {
+		res.End = u.End
+	}
+
+	return res, true
+}
+
+// Split cuts iv at the beginnings of the calendar units in the location of the start of iv,
+// e.g. at the first day of each month for UnitMonth, and returns the parts in order.
+// The first and the last parts may be shorter than a unit. Split returns nil if iv is empty.
+func (iv Interval) Split(unit Unit) []Interval {
+	var parts []Interval
+
+	for start := iv.Start; start.Before(iv.End); {
+		_, next := start.unitBounds(unit)
+		if !next.Before(iv.End) {
+			next = iv.End
+		}
+
+		parts = append(parts, Interval{start, next})
+		start = next
+	}
+
+	return parts
+}
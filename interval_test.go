@@ -0,0 +1,143 @@
+package ptime_test
+
+import (
+	"testing"
+	"time"
+
+	ptime "github.com/yaa110/go-persian-calendar"
+)
+
+func TestInterval(t *testing.T) {
+	at := func(month ptime.Month, day int) ptime.Time {
+		return ptime.Date(1403, month, day, 0, 0, 0, 0, ptime.Iran())
+	}
+
+	iv := ptime.NewInterval(at(ptime.Tir, 1), at(ptime.Farvardin, 1))
+	if iv.Start.Month() != ptime.Farvardin || iv.End.Month() != ptime.Tir || iv.IsEmpty() {
+		t.Error(
+			"Expected", "1403-01-01/1403-04-01",
+			"got", iv,
+		)
+	}
+
+	if iv.Duration() != 93*24*time.Hour {
+		t.Error(
+			"Expected", 93*24*time.Hour,
+			"got", iv.Duration(),
+		)
+	}
+
+	if !iv.Contains(at(ptime.Farvardin, 1)) || !iv.Contains(at(ptime.Khordad, 31)) || iv.Contains(at(ptime.Tir, 1)) {
+		t.Error("Expected", iv, "to be half-open")
+	}
+
+	u := ptime.NewInterval(at(ptime.Khordad, 1), at(ptime.Mehr, 1))
+	if !iv.Overlaps(u) || !u.Overlaps(iv) {
+		t.Error("Expected", iv, "to overlap", u)
+	}
+
+	if res, ok := iv.Intersect(u); !ok || res.String() != "1403-03-01T00:00:00.0+03:30/1403-04-01T00:00:00.0+03:30" {
+		t.Error(
+			"Expected", "1403-03-01T00:00:00.0+03:30/1403-04-01T00:00:00.0+03:30",
+			"got", res, ok,
+		)
+	}
+
+	if res, ok := iv.Union(u); !ok || res.String() != "1403-01-01T00:00:00.0+03:30/1403-07-01T00:00:00.0+03:30" {
+		t.Error(
+			"Expected", "1403-01-01T00:00:00.0+03:30/1403-07-01T00:00:00.0+03:30",
+			"got", res, ok,
+		)
+	}
+
+	adjacent := ptime.NewInterval(at(ptime.Tir, 1), at(ptime.Mordad, 1))
+	if iv.Overlaps(adjacent) {
+		t.Error("Expected", iv, "not to overlap", adjacent)
+	}
+
+	if _, ok := iv.Intersect(adjacent); ok {
+		t.Error("Expected no intersection of", iv, "and", adjacent)
+	}
+
+	if res, ok := iv.Union(adjacent); !ok || !res.End.Equal(at(ptime.Mordad, 1)) {
+		t.Error(
+			"Expected", "1403-01-01T00:00:00.0+03:30/1403-05-01T00:00:00.0+03:30",
+			"got", res, ok,
+		)
+	}
+
+	if _, ok := iv.Union(ptime.NewInterval(at(ptime.Mehr, 1), at(ptime.Aban, 1))); ok {
+		t.Error("Expected no union of disjoint intervals")
+	}
+
+	empty := ptime.Interval{Start: at(ptime.Tir, 1), End: at(ptime.Tir, 1)}
+	if !empty.IsEmpty() || empty.Duration() != 0 || empty.Overlaps(iv) || empty.Split(ptime.UnitDay) != nil {
+		t.Error("Expected", empty, "to be empty")
+	}
+}
+
+func TestIntervalSplit(t *testing.T) {
+	iv := ptime.NewInterval(
+		ptime.Date(1403, ptime.Bahman, 20, 12, 0, 0, 0, ptime.Iran()),
+		ptime.Date(1404, ptime.Farvardin, 10, 0, 0, 0, 0, ptime.Iran()),
+	)
+
+	expected := []string{
+		"1403-11-20T12:00:00.0+03:30/1403-12-01T00:00:00.0+03:30",
+		"1403-12-01T00:00:00.0+03:30/1404-01-01T00:00:00.0+03:30",
+		"1404-01-01T00:00:00.0+03:30/1404-01-10T00:00:00.0+03:30",
+	}
+
+	parts := iv.Split(ptime.UnitMonth)
+	if len(parts) != len(expected) {
+		t.Fatal(
+			"Expected", len(expected), "parts",
+			"got", parts,
+		)
+	}
+
+	for i, p := range parts {
+		if p.String() != expected[i] {
+			t.Error(
+				"Expected", expected[i],
+				"got", p,
+			)
+		}
+	}
+
+	// Esfand of the leap year 1403 has 30 days.
+	if d := parts[1].Duration(); d != 30*24*time.Hour {
+		t.Error(
+			"Expected", 30*24*time.Hour,
+			"got", d,
+		)
+	}
+
+	days := iv.Split(ptime.UnitDay)
+	if len(days) != 50 || !days[0].Start.Equal(iv.Start) || !days[len(days)-1].End.Equal(iv.End) {
+		t.Error(
+			"Expected", 50, "days",
+			"got", len(days),
+		)
+	}
+
+	weeks := iv.Split(ptime.UnitWeek)
+	if len(weeks) != 8 || weeks[1].Start.Weekday() != ptime.Shanbeh {
+		t.Error(
+			"Expected", 8, "weeks",
+			"got", weeks,
+		)
+	}
+
+	// The day of the DST transition in Tehran is 23 hours long.
+	dst := ptime.NewInterval(
+		ptime.Date(1400, ptime.Farvardin, 1, 0, 0, 0, 0, ptime.Iran()),
+		ptime.Date(1400, ptime.Farvardin, 3, 0, 0, 0, 0, ptime.Iran()),
+	).Split(ptime.UnitDay)
+	if len(dst) != 2 || dst[0].Duration() != 24*time.Hour || dst[1].Duration() != 23*time.Hour {
+		t.Error(
+			"Expected", "24h and 23h days",
+			"got", dst,
+		)
+	}
+}
@@ -0,0 +1,183 @@
+package ptime
+
+import (
+	"strings"
+	"time"
+)
+
+// ixdtfLayout is the Layout of the ParseError returned by ParseIXDTF.
+const ixdtfLayout = "RFC 9557"
+
+// ixdtfCalendar is the value of the calendar annotation of IXDTF strings.
+const ixdtfCalendar = "persian"
+
+// FormatIXDTF returns t in Internet Extended Date/Time Format (RFC 9557), i.e. the Gregorian time
+// in RFC 3339 format followed by the time zone and the calendar annotations, e.g.
+//
+//	2024-03-20T10:00:00+03:30[Asia/Tehran][u-ca=persian]
+//
+// The name of the location is used as the time zone, unless it is empty or Local
+// for which the zone offset is used instead.
+func (t Time) FormatIXDTF() string {
+	zone := t.loc.String()
+	if zone == "" || zone == "Local" {
+		zone = t.ZoneOffset()
+	}
+
+	return t.Time().Format(time.RFC3339Nano) + "[" + zone + "][u-ca=" + ixdtfCalendar + "]"
+}
+
+// ParseIXDTF parses a string in Internet Extended Date/Time Format (RFC 9557) and returns the time
+// value it represents. The RFC 3339 part of value is a Gregorian time which is converted by SetTime.
+//
+// The time is returned in the location of the time zone annotation, which may be
+// an IANA name or a zone offset. If the offset of the RFC 3339 part is not Z and is inconsistent
+// with the time zone, the offset is used, unless the time zone is critical (e.g. [!Asia/Tehran])
+// in which case an error is returned.
+//
+// The calendar annotation u-ca must be persian if it is critical, otherwise it is ignored.
+// Any other annotation is ignored, unless it is critical in which case an error is returned.
+//
+// Errors are of type *ParseError.
+func ParseIXDTF(value string) (Time, error) {
+	fail := func(offset int, msg string) (Time, error) {
+		return Time{}, &ParseError{Layout: ixdtfLayout, Value: value, Offset: offset, Message: msg}
+	}
+
+	base := value
+	if i := strings.IndexByte(value, '['); i >= 0 {
+		base = value[:i]
+	}
+
+	gt, err := time.Parse(time.RFC3339Nano, base)
+	if err != nil {
+		return fail(0, "invalid RFC 3339 time")
+	}
+
+	unknownOffset := strings.HasSuffix(base, "Z")
+
+	var (
+		loc          *time.Location
+		zoneCritical bool
+		hasCalendar  bool
+		calCritical  bool
+	)
+
+	for j := len(base); j < len(value); {
+		if value[j] != '[' {
+			return fail(j, "expected annotation")
+		}
+
+		end := strings.IndexByte(value[j:], ']')
+		if end < 0 {
+			return fail(j, "unterminated annotation")
+		}
+
+		content := value[j+1 : j+end]
+		critical := strings.HasPrefix(content, "!")
+		content = strings.TrimPrefix(content, "!")
+
+		key, val, isTag := strings.Cut(content, "=")
+
+		switch {
+		case !isTag:
+			if j != len(base) {
+				return fail(j, "time zone must be the first annotation")
+			}
+
+			l, ok := ixdtfZone(content)
+			if !ok {
+				if critical {
+					return fail(j, "unknown time zone "+content)
+				}
+				break
+			}
+
+			loc, zoneCritical = l, critical
+		case !isAnnotationKey(key) || !isAnnotationValue(val):
+			return fail(j, "invalid annotation")
+		case key == "u-ca":
+			if hasCalendar {
+				if critical || calCritical {
+					return fail(j, "duplicate critical calendar annotation")
+				}
+				break
+			}
+
+			if critical && val != ixdtfCalendar {
+				return fail(j, "unsupported calendar "+val)
+			}
+
+			hasCalendar, calCritical = true, critical
+		case critical:
+			return fail(j, "unknown critical annotation "+key)
+		}
+
+		j += end + 1
+	}
+
+	if loc != nil {
+		_, offset := gt.Zone()
+		if _, o := gt.In(loc).Zone(); unknownOffset || o == offset {
+			gt = gt.In(loc)
+		} else if zoneCritical {
+			return fail(len(base), "time zone is inconsistent with the offset")
+		}
+	}
+
+	var t Time
+	t.SetTime(gt)
+
+	return t, nil
+}
+
+// ixdtfZone returns the location of an IXDTF time zone, which is either a zone offset or an IANA name.
+func ixdtfZone(zone string) (*time.Location, bool) {
+	if zone == "" {
+		return nil, false
+	}
+
+	if zone[0] == '+' || zone[0] == '-' {
+		offset, n, ok := parseZoneOffset(zone, true, true)
+		if !ok || n != len(zone) {
+			return nil, false
+		}
+		return time.FixedZone("", offset), true
+	}
+
+	loc, err := loadLocation(zone, nil)
+	if err != nil {
+		return nil, false
+	}
+
+	return loc, true
+}
+
+// isAnnotationKey reports whether key is a valid IXDTF annotation key, i.e. [a-z_][a-z0-9_-]*.
+func isAnnotationKey(key string) bool {
+	for i := 0; i < len(key); i++ {
+		c := key[i]
+		if (c < 'a' || c > 'z') && c != '_' && (i == 0 || (!isDigit(c) && c != '-')) {
+			return false
+		}
+	}
+	return key != ""
+}
+
+// isAnnotationValue reports whether val is a valid IXDTF annotation value,
+// i.e. alphanumeric parts of 1 to 8 characters which are separated by dashes.
+func isAnnotationValue(val string) bool {
+	for _, part := range strings.Split(val, "-") {
+		if len(part) < 1 || len(part) > 8 {
+			return false
+		}
+
+		for i := 0; i < len(part); i++ {
+			c := part[i]
+			if (c < 'a' || c > 'z') && (c < 'A' || c > 'Z') && !isDigit(c) {
+				return false
+			}
+		}
+	}
+	return true
+}
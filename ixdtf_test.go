@@ -0,0 +1,90 @@
+package ptime_test
+
+import (
+	"testing"
+	"time"
+
+	ptime "github.com/yaa110/go-persian-calendar"
+)
+
+func TestFormatIXDTF(t *testing.T) {
+	vals := []struct {
+		t ptime.Time
+		s string
+	}{
+		{ptime.Date(1403, ptime.Farvardin, 1, 10, 0, 0, 0, ptime.Iran()), "2024-03-20T10:00:00+03:30[Asia/Tehran][u-ca=persian]"},
+		{ptime.Date(1403, ptime.Farvardin, 1, 10, 0, 0, 5e8, time.UTC), "2024-03-20T10:00:00.5Z[UTC][u-ca=persian]"},
+		{ptime.Date(1403, ptime.Farvardin, 1, 10, 0, 0, 0, time.FixedZone("", -12600)), "2024-03-20T10:00:00-03:30[-03:30][u-ca=persian]"},
+	}
+
+	for _, v := range vals {
+		if s := v.t.FormatIXDTF(); s != v.s {
+			t.Error(
+				"Expected", v.s,
+				"got", s,
+			)
+		}
+
+		pt, err := ptime.ParseIXDTF(v.s)
+		if err != nil || pt.String() != v.t.String() || pt.Weekday() != v.t.Weekday() || pt.FormatIXDTF() != v.s {
+			t.Error(
+				"For", v.s,
+				"expected", v.t,
+				"got", pt, err,
+			)
+		}
+	}
+}
+
+func TestParseIXDTF(t *testing.T) {
+	tehran := ptime.Date(1403, ptime.Farvardin, 1, 10, 0, 0, 0, ptime.Iran())
+
+	vals := map[string]string{
+		"2024-03-20T10:00:00+03:30":                                       "1403-01-01T10:00:00.0+03:30",
+		"2024-03-20T06:30:00Z[Asia/Tehran]":                               "1403-01-01T10:00:00.0+03:30",
+		"2024-03-20T10:00:00+03:30[!Asia/Tehran][!u-ca=persian]":          "1403-01-01T10:00:00.0+03:30",
+		"2024-03-20T10:00:00+03:30[Asia/Tehran][u-ca=gregory]":            "1403-01-01T10:00:00.0+03:30",
+		"2024-03-20T10:00:00+03:30[u-ca=persian][_foo=bar][u-ca=iso8601]": "1403-01-01T10:00:00.0+03:30",
+		"2024-03-20T11:00:00+04:30[Asia/Tehran]":                          "1403-01-01T11:00:00.0+04:30",
+		"2024-03-20T10:00:00+03:30[Mars/Olympus]":                         "1403-01-01T10:00:00.0+03:30",
+	}
+
+	for v, s := range vals {
+		pt, err := ptime.ParseIXDTF(v)
+		if err != nil || pt.String() != s || !pt.Equal(tehran) {
+			t.Error(
+				"For", v,
+				"expected", s,
+				"got", pt, err,
+			)
+		}
+	}
+
+	pt, _ := ptime.ParseIXDTF("2024-03-20T06:30:00Z[Asia/Tehran]")
+	if pt.Location().String() != "Asia/Tehran" {
+		t.Error(
+			"Expected", "Asia/Tehran",
+			"got", pt.Location(),
+		)
+	}
+
+	invalid := []string{
+		"1403-01-01",
+		"2024-03-20T10:00:00+03:30[!Mars/Olympus]",
+		"2024-03-20T11:00:00+04:30[!Asia/Tehran]",
+		"2024-03-20T10:00:00+03:30[!u-ca=gregory]",
+		"2024-03-20T10:00:00+03:30[u-ca=persian][!u-ca=persian]",
+		"2024-03-20T10:00:00+03:30[!foo=bar]",
+		"2024-03-20T10:00:00+03:30[Foo=bar]",
+		"2024-03-20T10:00:00+03:30[foo=toolongvalue]",
+		"2024-03-20T10:00:00+03:30[u-ca=persian][Asia/Tehran]",
+		"2024-03-20T10:00:00+03:30[Asia/Tehran",
+		"2024-03-20T10:00:00+03:30[Asia/Tehran]x",
+	}
+
+	for _, v := range invalid {
+		if _, err := ptime.ParseIXDTF(v); err == nil {
+			t.Error("Expected an error for", v)
+		}
+	}
+}
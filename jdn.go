@@ -0,0 +1,147 @@
+package ptime
+
+import (
+	"math"
+	"time"
+)
+
+// List of the Julian Day Numbers of the epochs of day counts.
+const (
+	unixEpochJDN     = 2440588 // January 1, 1970 in Gregorian calendar
+	rataDieEpochJDN  = 1721425 // December 31, 0 in proleptic Gregorian calendar, the day before R.D. 1
+	modifiedJDOffset = 2400000.5
+)
+
+// JulianDayNumber returns the Julian Day Number of the date of t in its location, which is the number
+// of days since January 1, 4713 BC in proleptic Julian calendar. The time of day is ignored.
+func (t Time) JulianDayNumber() int {
+	return t.jdn()
+}
+
+// JulianDate returns the astronomical Julian Date of the instant of t, which is the number of days
+// since noon Universal Time of January 1, 4713 BC in proleptic Julian calendar, with the fraction of the day.
+func (t Time) JulianDate() float64 {
+	ti := t.Time()
+	sec := ti.Unix()
+
+	days := sec / 86400
+	if sec%86400 < 0 {
+		days--
+	}
+
+	frac := float64(sec-days*86400)/86400 + float64(ti.Nanosecond())/86400e9
+
+	return float64(unixEpochJDN+days) - 0.5 + frac
+}
+
+// ModifiedJulianDate returns the Modified Julian Date of the instant of t, which is the number of days
+// since midnight Universal Time of November 17, 1858, with the fraction of the day.
+func (t Time) ModifiedJulianDate() float64 {
+	return t.JulianDate() - modifiedJDOffset
+}
+
+// RataDie returns the Rata Die (R.D.) of the date of t in its location, which is the number of days
+// since December 31, 0 in proleptic Gregorian calendar, i.e. January 1, 1 is R.D. 1.
+func (t Time) RataDie() int {
+	return t.jdn() - rataDieEpochJDN
+}
+
+// JulianDayNumber returns the Julian Day Number of d.
+func (d CivilDate) JulianDayNumber() int {
+	return d.jdn()
+}
+
+// FromJulianDayNumber returns a new instance of Time at 00:00:00 of the day of the Julian Day Number jdn
+// in loc, which is the inverse of JulianDayNumber. If loc is nil then the local time is used.
+func FromJulianDayNumber(jdn int, loc *time.Location) Time {
+	return civilDateOfJDN(jdn).At(0, 0, 0, 0, loc)
+}
+
+// FromJulianDate returns a new instance of Time for the instant of the astronomical Julian Date jd
+// in loc, which is the inverse of JulianDate. If loc is nil then the local time is used.
+// The precision of jd is about 20 microseconds for the present dates.
+func FromJulianDate(jd float64, loc *time.Location) Time {
+	if loc == nil {
+		//nolint:gosmopolitan
+		loc = time.Local
+	}
+
+	days := math.Floor(jd + 0.5)
+	nsec := math.Round((jd + 0.5 - days) * 86400e9)
+
+	ti := time.Unix((int64(days)-unixEpochJDN)*86400, int64(nsec))
+
+	return New(ti.In(loc))
+}
+
+// PersianToJDN returns the Julian Day Number of a date in Persian calendar by ArithmeticCalendar.
+// The month and day values may be outside their usual ranges and are normalized.
+func PersianToJDN(year, month, day int) int {
+	year, month = normMonth(year, month)
+	return shamsiToJDN(defaultCalendar, year, month, day)
+}
+
+// JDNToPersian returns the date in Persian calendar of the Julian Day Number by ArithmeticCalendar.
+func JDNToPersian(jdn int) (year, month, day int) {
+	return jdnToShamsi(defaultCalendar, jdn)
+}
+
+// GregorianToJDN returns the Julian Day Number of a date in proleptic Gregorian calendar.
+// The month and day values may be outside their usual ranges and are normalized.
+func GregorianToJDN(year, month, day int) int {
+	year, month = normMonth(year, month)
+	return convertGregorianPostReformToJDN(year, month, day)
+}
+
+// JDNToGregorian returns the date in proleptic Gregorian calendar of the Julian Day Number.
+func JDNToGregorian(jdn int) (year, month, day int) {
+	return convertJDNToGregorianPostReform(jdn)
+}
+
+// JulianToJDN returns the Julian Day Number of a date in proleptic Julian calendar.
+// The month and day values may be outside their usual ranges and are normalized.
+func JulianToJDN(year, month, day int) int {
+	year, month = normMonth(year, month)
+	return convertGregorianPreReformToJDN(year, month, day)
+}
+
+// JDNToJulian returns the date in proleptic Julian calendar of the Julian Day Number.
+func JDNToJulian(jdn int) (year, month, day int) {
+	return convertJDNToGregorianPreReform(jdn)
+}
+
+// PersianToGregorian converts a date in Persian calendar to proleptic Gregorian calendar.
+func PersianToGregorian(year, month, day int) (int, int, int) {
+	return JDNToGregorian(PersianToJDN(year, month, day))
+}
+
+// GregorianToPersian converts a date in proleptic Gregorian calendar to Persian calendar.
+func GregorianToPersian(year, month, day int) (int, int, int) {
+	return JDNToPersian(GregorianToJDN(year, month, day))
+}
+
+// PersianToJulian converts a date in Persian calendar to proleptic Julian calendar.
+func PersianToJulian(year, month, day int) (int, int, int) {
+	return JDNToJulian(PersianToJDN(year, month, day))
+}
+
+// JulianToPersian converts a date in proleptic Julian calendar to Persian calendar.
+func JulianToPersian(year, month, day int) (int, int, int) {
+	return JDNToPersian(JulianToJDN(year, month, day))
+}
+
+// GregorianToJulian converts a date in proleptic Gregorian calendar to proleptic Julian calendar.
+func GregorianToJulian(year, month, day int) (int, int, int) {
+	return JDNToJulian(GregorianToJDN(year, month, day))
+}
+
+// JulianToGregorian converts a date in proleptic Julian calendar to proleptic Gregorian calendar.
+func JulianToGregorian(year, month, day int) (int, int, int) {
+	return JDNToGregorian(JulianToJDN(year, month, day))
+}
+
+// normMonth normalizes month overflowing into year, so that month is in the range [1, 12].
+func normMonth(year, month int) (int, int) {
+	year, month = norm(year, month-1, 12)
+	return year, month + 1
+}
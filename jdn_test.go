@@ -0,0 +1,124 @@
+package ptime_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	ptime "github.com/yaa110/go-persian-calendar"
+)
+
+func TestJulianDayNumber(t *testing.T) {
+	pt := ptime.Date(1403, ptime.Farvardin, 1, 23, 0, 0, 0, ptime.Iran())
+
+	if pt.JulianDayNumber() != 2460390 || pt.CivilDate().JulianDayNumber() != 2460390 {
+		t.Error(
+			"Expected", 2460390,
+			"got", pt.JulianDayNumber(), pt.CivilDate().JulianDayNumber(),
+		)
+	}
+
+	if pt.RataDie() != 738965 {
+		t.Error(
+			"Expected", 738965,
+			"got", pt.RataDie(),
+		)
+	}
+
+	ft := ptime.FromJulianDayNumber(2460390, ptime.Iran())
+	if ft.Format("yyyy-MM-dd HH:mm") != "1403-01-01 00:00" || ft.Weekday() != ptime.Charshanbeh {
+		t.Error(
+			"Expected", "1403-01-01 00:00", ptime.Charshanbeh,
+			"got", ft.Format("yyyy-MM-dd HH:mm"), ft.Weekday(),
+		)
+	}
+}
+
+func TestJulianDate(t *testing.T) {
+	// J2000.0 is January 1, 2000 at 12:00 UTC.
+	pt := ptime.New(time.Date(2000, time.January, 1, 12, 0, 0, 0, time.UTC))
+
+	if pt.JulianDate() != 2451545 || pt.ModifiedJulianDate() != 51544.5 {
+		t.Error(
+			"Expected", 2451545, 51544.5,
+			"got", pt.JulianDate(), pt.ModifiedJulianDate(),
+		)
+	}
+
+	// The Julian Date does not depend on the location.
+	pt = ptime.New(time.Date(1969, time.December, 31, 18, 0, 0, 0, time.UTC).In(ptime.Iran()))
+	if pt.JulianDate() != 2440587.25 {
+		t.Error(
+			"Expected", 2440587.25,
+			"got", pt.JulianDate(),
+		)
+	}
+
+	// The Julian Date agrees with the Julian Day Number before the Gregorian reform.
+	pt = ptime.New(time.Date(900, time.January, 1, 12, 0, 0, 0, time.UTC))
+	if jdn := ptime.GregorianToJDN(900, 1, 1); pt.JulianDayNumber() != jdn || pt.JulianDate() != float64(jdn) {
+		t.Error(
+			"Expected", jdn,
+			"got", pt.JulianDayNumber(), pt.JulianDate(),
+		)
+	}
+
+	gt := time.Date(2024, time.March, 20, 3, 6, 21, 500000000, time.UTC)
+	ft := ptime.FromJulianDate(ptime.New(gt).JulianDate(), time.UTC)
+	if d := ft.Time().Sub(gt); math.Abs(float64(d)) > float64(100*time.Microsecond) {
+		t.Error(
+			"Expected", gt,
+			"got", ft.Time(),
+		)
+	}
+}
+
+func TestDateConversions(t *testing.T) {
+	tests := []struct {
+		persian   [3]int
+		gregorian [3]int
+		julian    [3]int
+	}{
+		{[3]int{1403, 1, 1}, [3]int{2024, 3, 20}, [3]int{2024, 3, 7}},
+		{[3]int{1079, 12, 29}, [3]int{1701, 3, 20}, [3]int{1701, 3, 9}},
+		{[3]int{961, 7, 23}, [3]int{1582, 10, 15}, [3]int{1582, 10, 5}},
+		{[3]int{-621, 10, 11}, [3]int{1, 1, 1}, [3]int{1, 1, 3}},
+	}
+
+	for _, tt := range tests {
+		p, g, j := tt.persian, tt.gregorian, tt.julian
+
+		if y, m, d := ptime.PersianToGregorian(p[0], p[1], p[2]); [3]int{y, m, d} != g {
+			t.Error("For", p, "expected", g, "got", y, m, d)
+		}
+
+		if y, m, d := ptime.GregorianToPersian(g[0], g[1], g[2]); [3]int{y, m, d} != p {
+			t.Error("For", g, "expected", p, "got", y, m, d)
+		}
+
+		if y, m, d := ptime.PersianToJulian(p[0], p[1], p[2]); [3]int{y, m, d} != j {
+			t.Error("For", p, "expected", j, "got", y, m, d)
+		}
+
+		if y, m, d := ptime.JulianToPersian(j[0], j[1], j[2]); [3]int{y, m, d} != p {
+			t.Error("For", j, "expected", p, "got", y, m, d)
+		}
+
+		if y, m, d := ptime.GregorianToJulian(g[0], g[1], g[2]); [3]int{y, m, d} != j {
+			t.Error("For", g, "expected", j, "got", y, m, d)
+		}
+
+		if y, m, d := ptime.JulianToGregorian(j[0], j[1], j[2]); [3]int{y, m, d} != g {
+			t.Error("For", j, "expected", g, "got", y, m, d)
+		}
+	}
+
+	// The month and day are normalized.
+	if a, b := ptime.GregorianToJDN(2024, 14, 31), ptime.GregorianToJDN(2025, 3, 3); a != b {
+		t.Error("Expected", b, "got", a)
+	}
+
+	if a, b := ptime.PersianToJDN(1402, 13, 0), ptime.PersianToJDN(1402, 12, 29); a != b {
+		t.Error("Expected", b, "got", a)
+	}
+}
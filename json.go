@@ -0,0 +1,136 @@
+package ptime
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+)
+
+// GregorianTime is a Time which is encoded in JSON as a Gregorian time in RFC 3339 format,
+// e.g. "2015-09-24T14:07:08+03:30". A Time itself is encoded as a Persian time, see Time.MarshalJSON.
+type GregorianTime struct {
+	Time
+}
+
+// DetailedTime is a Time which is encoded in JSON as an object which has the Persian time,
+// the Gregorian time in RFC 3339 format and the Unix timestamp in seconds, e.g.
+//
+//	{"persian":"1394-07-02T14:07:08.0+03:30[Asia/Tehran]","gregorian":"2015-09-24T14:07:08+03:30","unix":1443091028}
+type DetailedTime struct {
+	Time
+}
+
+// detailedTime is the JSON representation of DetailedTime.
+type detailedTime struct {
+	Persian   string `json:"persian"`
+	Gregorian string `json:"gregorian"`
+	Unix      *int64 `json:"unix"`
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// The time is formatted in RFC 3339 format with nanoseconds in Gregorian calendar.
+// The zero GregorianTime is encoded as an empty text.
+func (t GregorianTime) MarshalText() ([]byte, error) {
+	if t.IsZero() {
+		return []byte{}, nil
+	}
+	return []byte(t.Time.Time().Format(time.RFC3339Nano)), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// The text must be a Gregorian time in RFC 3339 format. An empty text is decoded as the zero GregorianTime.
+func (t *GregorianTime) UnmarshalText(data []byte) error {
+	if len(data) == 0 {
+		t.Time = Time{}
+		return nil
+	}
+
+	gt, err := time.Parse(time.RFC3339Nano, string(data))
+	if err != nil {
+		return err
+	}
+
+	t.Time = New(gt)
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// The time is encoded as a JSON string in the format of MarshalText, and the zero GregorianTime as null.
+func (t GregorianTime) MarshalJSON() ([]byte, error) {
+	if t.IsZero() {
+		return []byte("null"), nil
+	}
+
+	text, _ := t.MarshalText()
+	return json.Marshal(string(text))
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// The time must be a JSON string in the format of MarshalText. JSON null is a no-op.
+func (t *GregorianTime) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return errors.New("ptime: GregorianTime.UnmarshalJSON: input is not a JSON string")
+	}
+
+	return t.UnmarshalText([]byte(s))
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// The zero DetailedTime is encoded as null.
+func (t DetailedTime) MarshalJSON() ([]byte, error) {
+	if t.IsZero() {
+		return []byte("null"), nil
+	}
+
+	persian, err := t.Time.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+
+	gregorian, _ := GregorianTime(t).MarshalText()
+	unix := t.Unix()
+
+	return json.Marshal(detailedTime{
+		Persian:   string(persian),
+		Gregorian: string(gregorian),
+		Unix:      &unix,
+	})
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+//
+// The time is decoded from the first member of the object which is present, in the order of
+// persian, gregorian and unix. Only the persian member restores the location of the time.
+// JSON null is a no-op.
+func (t *DetailedTime) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+
+	var d detailedTime
+	if err := json.Unmarshal(data, &d); err != nil {
+		return errors.New("ptime: DetailedTime.UnmarshalJSON: input is not a JSON object")
+	}
+
+	switch {
+	case d.Persian != "":
+		return t.Time.UnmarshalText([]byte(d.Persian))
+	case d.Gregorian != "":
+		var gt GregorianTime
+		if err := gt.UnmarshalText([]byte(d.Gregorian)); err != nil {
+			return err
+		}
+		t.Time = gt.Time
+	case d.Unix != nil:
+		t.Time = Unix(*d.Unix, 0)
+	default:
+		return errors.New("ptime: DetailedTime.UnmarshalJSON: object has no time")
+	}
+
+	return nil
+}
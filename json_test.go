@@ -0,0 +1,81 @@
+package ptime_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	ptime "github.com/yaa110/go-persian-calendar"
+)
+
+type jsonRepresentations struct {
+	Persian   ptime.Time          `json:"persian"`
+	Gregorian ptime.GregorianTime `json:"gregorian"`
+	Detailed  ptime.DetailedTime  `json:"detailed"`
+}
+
+func TestJSONRepresentations(t *testing.T) {
+	ti := ptime.Date(1394, ptime.Mehr, 2, 14, 7, 8, 0, ptime.Iran())
+
+	b, err := json.Marshal(jsonRepresentations{ti, ptime.GregorianTime{ti}, ptime.DetailedTime{ti}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `{"persian":"1394-07-02T14:07:08.0+03:30[Asia/Tehran]",` +
+		`"gregorian":"2015-09-24T14:07:08+03:30",` +
+		`"detailed":{"persian":"1394-07-02T14:07:08.0+03:30[Asia/Tehran]","gregorian":"2015-09-24T14:07:08+03:30","unix":1443091028}}`
+	if string(b) != expected {
+		t.Error(
+			"Expected", expected,
+			"got", string(b),
+		)
+	}
+
+	var got jsonRepresentations
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatal(err)
+	}
+
+	if !got.Persian.Equal(ti) || !got.Gregorian.Equal(ti) || !got.Detailed.Equal(ti) {
+		t.Error(
+			"Expected", ti,
+			"got", got.Persian, got.Gregorian.Time, got.Detailed.Time,
+		)
+	}
+
+	if got.Detailed.Location().String() != "Asia/Tehran" {
+		t.Error(
+			"Expected", "Asia/Tehran",
+			"got", got.Detailed.Location(),
+		)
+	}
+
+	inputs := []string{
+		`{"gregorian":"2015-09-24T10:37:08Z"}`,
+		`{"unix":1443091028}`,
+	}
+
+	for _, in := range inputs {
+		var d ptime.DetailedTime
+		if err := json.Unmarshal([]byte(in), &d); err != nil || !d.Equal(ti) {
+			t.Error(
+				"For", in,
+				"expected", ti,
+				"got", d.Time, err,
+			)
+		}
+	}
+
+	b, _ = json.Marshal(jsonRepresentations{})
+	if string(b) != `{"persian":null,"gregorian":null,"detailed":null}` {
+		t.Error(
+			"Expected", `{"persian":null,"gregorian":null,"detailed":null}`,
+			"got", string(b),
+		)
+	}
+
+	var d ptime.DetailedTime
+	if err := json.Unmarshal([]byte(`{}`), &d); err == nil {
+		t.Error("Expected an error for an empty object")
+	}
+}
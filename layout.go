@@ -0,0 +1,253 @@
+package ptime
+
+import "unicode/utf8"
+
+// A token is a layout element recognized by Format and Parse.
+type token int
+
+// List of layout tokens.
+const (
+	tokenLiteral      token = iota // any other text
+	tokenYear                      // yyyy, yyy, y
+	tokenYear2                     // yy
+	tokenMonthName                 // MMM
+	tokenMonthDari                 // MMI
+	tokenZeroMonth                 // MM
+	tokenMonth                     // M
+	tokenSeasonName                // QQQ
+	tokenSeasonDari                // QQI
+	tokenQuarter                   // Q
+	tokenRYearWeek                 // rw
+	tokenYearWeek                  // w
+	tokenMonthWeek                 // W
+	tokenRYearDay                  // RD
+	tokenYearDay                   // D
+	tokenRMonthDay                 // rd
+	tokenZeroDay                   // dd
+	tokenDay                       // d
+	tokenWeekday                   // E
+	tokenWeekdayShort              // e
+	tokenAmPm                      // A
+	tokenAmPmShort                 // a
+	tokenZeroHour                  // HH
+	tokenHour                      // H
+	tokenZeroHour24                // kk
+	tokenHour24                    // k
+	tokenZeroHour12                // hh
+	tokenHour12                    // h
+	tokenZeroHour11                // KK
+	tokenHour11                    // K
+	tokenZeroMinute                // mm
+	tokenMinute                    // m
+	tokenZeroSecond                // ss
+	tokenSecond                    // s
+	tokenDayTime                   // n
+	tokenNanosecond                // ns
+	tokenMillisecond               // S
+	tokenZoneName                  // z
+	tokenZoneOffset                // Z
+)
+
+// nextToken returns the token at the beginning of layout and its length in bytes.
+// Any text which is not a token is returned as a single-rune tokenLiteral.
+func nextToken(layout string) (token, int) {
+	// peek reports whether the byte at index i of layout is c.
+	peek := func(i int, c byte) bool {
+		return i < len(layout) && layout[i] == c
+	}
+
+	switch layout[0] {
+	case 'A':
+		return tokenAmPm, 1
+	case 'D':
+		return tokenYearDay, 1
+	case 'E':
+		return tokenWeekday, 1
+	case 'H':
+		if peek(1, 'H') {
+			return tokenZeroHour, 2
+		}
+		return tokenHour, 1
+	case 'K':
+		if peek(1, 'K') {
+			return tokenZeroHour11, 2
+		}
+		return tokenHour11, 1
+	case 'M':
+		switch {
+		case peek(1, 'M') && peek(2, 'M'):
+			return tokenMonthName, 3
+		case peek(1, 'M') && peek(2, 'I'):
+			return tokenMonthDari, 3
+		case peek(1, 'M'):
+			return tokenZeroMonth, 2
+		}
+		return tokenMonth, 1
+	case 'Q':
+		switch {
+		case peek(1, 'Q') && peek(2, 'Q'):
+			return tokenSeasonName, 3
+		case peek(1, 'Q') && peek(2, 'I'):
+			return tokenSeasonDari, 3
+		}
+		return tokenQuarter, 1
+	case 'R':
+		if peek(1, 'D') {
+			return tokenRYearDay, 2
+		}
+	case 'S':
+		return tokenMillisecond, 1
+	case 'W':
+		return tokenMonthWeek, 1
+	case 'Z':
+		return tokenZoneOffset, 1
+	case 'a':
+		return tokenAmPmShort, 1
+	case 'd':
+		if peek(1, 'd') {
+			return tokenZeroDay, 2
+		}
+		return tokenDay, 1
+	case 'e':
+		return tokenWeekdayShort, 1
+	case 'h':
+		if peek(1, 'h') {
+			return tokenZeroHour12, 2
+		}
+		return tokenHour12, 1
+	case 'k':
+		if peek(1, 'k') {
+			return tokenZeroHour24, 2
+		}
+		return tokenHour24, 1
+	case 'm':
+		if peek(1, 'm') {
+			return tokenZeroMinute, 2
+		}
+		return tokenMinute, 1
+	case 'n':
+		if peek(1, 's') {
+			return tokenNanosecond, 2
+		}
+		return tokenDayTime, 1
+	case 'r':
+		switch {
+		case peek(1, 'w'):
+			return tokenRYearWeek, 2
+		case peek(1, 'd'):
+			return tokenRMonthDay, 2
+		}
+	case 's':
+		if peek(1, 's') {
+			return tokenZeroSecond, 2
+		}
+		return tokenSecond, 1
+	case 'w':
+		return tokenYearWeek, 1
+	case 'y':
+		switch {
+		case peek(1, 'y') && peek(2, 'y') && peek(3, 'y'):
+			return tokenYear, 4
+		case peek(1, 'y') && peek(2, 'y'):
+			return tokenYear, 3
+		case peek(1, 'y'):
+			return tokenYear2, 2
+		}
+		return tokenYear, 1
+	case 'z':
+		return tokenZoneName, 1
+	}
+
+	_, n := utf8.DecodeRuneInString(layout)
+	return tokenLiteral, n
+}
+
+// A stdToken is a layout element recognized by TimeFormat and ParseTimeFormat.
+type stdToken int
+
+// List of standard layout tokens.
+const (
+	stdLiteral        stdToken = iota // any other text
+	stdLongMonth                      // January
+	stdMonth                          // Jan
+	stdLongWeekDay                    // Monday
+	stdWeekDay                        // Mon
+	stdDayTime                        // Morning
+	stdFracSecond0                    // .000, .000000, .000000000
+	stdFracSecond9                    // .999, .999999, .999999999
+	stdLongYear                       // 2006
+	stdPM                             // PM
+	stdpm                             // pm
+	stdTZ                             // MST
+	stdISO8601TZ                      // Z0700
+	stdISO8601ColonTZ                 // Z07:00
+	stdNumTZ                          // -0700
+	stdNumColonTZ                     // -07:00
+	stdNumShortTZ                     // -07
+	stdHour                           // 15
+	stdYear                           // 06
+	stdZeroMonth                      // 01
+	stdZeroDay                        // 02
+	stdZeroHour12                     // 03
+	stdZeroMinute                     // 04
+	stdZeroSecond                     // 05
+	stdUnderDay                       // _2
+	stdNumMonth                       // 1
+	stdDay                            // 2
+	stdHour12                         // 3
+	stdMinute                         // 4
+	stdSecond                         // 5
+)
+
+// stdTokens lists the standard layout elements in the order of precedence.
+var stdTokens = [...]struct {
+	layout string
+	token  stdToken
+}{
+	{"January", stdLongMonth},
+	{"Jan", stdMonth},
+	{"Monday", stdLongWeekDay},
+	{"Mon", stdWeekDay},
+	{"Morning", stdDayTime},
+	{".000000000", stdFracSecond0},
+	{".000000", stdFracSecond0},
+	{".000", stdFracSecond0},
+	{".999999999", stdFracSecond9},
+	{".999999", stdFracSecond9},
+	{".999", stdFracSecond9},
+	{"2006", stdLongYear},
+	{"PM", stdPM},
+	{"pm", stdpm},
+	{"MST", stdTZ},
+	{"Z0700", stdISO8601TZ},
+	{"Z07:00", stdISO8601ColonTZ},
+	{"-0700", stdNumTZ},
+	{"-07:00", stdNumColonTZ},
+	{"-07", stdNumShortTZ},
+	{"15", stdHour},
+	{"06", stdYear},
+	{"01", stdZeroMonth},
+	{"02", stdZeroDay},
+	{"03", stdZeroHour12},
+	{"04", stdZeroMinute},
+	{"05", stdZeroSecond},
+	{"_2", stdUnderDay},
+	{"1", stdNumMonth},
+	{"2", stdDay},
+	{"3", stdHour12},
+	{"4", stdMinute},
+	{"5", stdSecond},
+}
+
+// nextStdToken returns the standard token at the beginning of layout and its length in bytes.
+// Any text which is not a token is returned as a single-rune stdLiteral.
+func nextStdToken(layout string) (stdToken, int) {
+	for _, std := range stdTokens {
+		if len(layout) >= len(std.layout) && layout[:len(std.layout)] == std.layout {
+			return std.token, len(std.layout)
+		}
+	}
+
+	_, n := utf8.DecodeRuneInString(layout)
+	return stdLiteral, n
+}
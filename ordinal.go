@@ -0,0 +1,115 @@
+package ptime
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ordinalDateLayout is the Layout of the ParseError returned by ParseOrdinalDate.
+const ordinalDateLayout = "yyyy-DDD"
+
+// List of errors returned by FromYearDay, FromYearWeek and FromMonthWeek.
+var (
+	ErrInvalidYearDay = errors.New("ptime: invalid day of year")
+	ErrInvalidWeek    = errors.New("ptime: invalid week")
+	ErrInvalidWeekday = errors.New("ptime: invalid weekday")
+)
+
+// FromYearDay returns a new instance of Time at 00:00:00 of the day of year yday in loc,
+// which is the inverse of YearDay. If loc is nil then the local time is used.
+// The error wraps ErrInvalidYearDay if yday is not in the range [1, 365], or [1, 366] in a leap year.
+func FromYearDay(year, yday int, loc *time.Location) (Time, error) {
+	if n := Year(year).Days(); yday < 1 || yday > n {
+		return Time{}, fmt.Errorf("%w %d, %d has %d days", ErrInvalidYearDay, yday, year, n)
+	}
+
+	return Date(year, Farvardin, yday, 0, 0, 0, 0, loc), nil
+}
+
+// FromYearWeek returns a new instance of Time at 00:00:00 of the weekday of the week of year in loc,
+// which is the inverse of YearWeek and Weekday. If loc is nil then the local time is used.
+// The first and the last weeks of year may be partial, so the error wraps ErrInvalidWeek if the day is
+// not in year, or ErrInvalidWeekday if weekday is not in the range [Shanbeh, Jomeh].
+func FromYearWeek(year, week int, weekday Weekday, loc *time.Location) (Time, error) {
+	if weekday < Shanbeh || weekday > Jomeh {
+		return Time{}, fmt.Errorf("%w %d", ErrInvalidWeekday, weekday)
+	}
+
+	yday := weekDay(defaultCalendar.YearStart(year), week, weekday)
+	if yday < 1 || yday > Year(year).Days() {
+		return Time{}, fmt.Errorf("%w, %s of week %d is not in %d", ErrInvalidWeek, weekday, week, year)
+	}
+
+	return Date(year, Farvardin, yday, 0, 0, 0, 0, loc), nil
+}
+
+// FromMonthWeek returns a new instance of Time at 00:00:00 of the weekday of the week of month in loc,
+// which is the inverse of MonthWeek and Weekday. If loc is nil then the local time is used.
+// The first and the last weeks of month may be partial, so the error wraps ErrInvalidWeek if the day is
+// not in month, ErrInvalidWeekday if weekday is not in the range [Shanbeh, Jomeh] or ErrInvalidMonth.
+func FromMonthWeek(year int, month Month, week int, weekday Weekday, loc *time.Location) (Time, error) {
+	if month < Farvardin || month > Esfand {
+		return Time{}, fmt.Errorf("%w %d", ErrInvalidMonth, month)
+	}
+
+	if weekday < Shanbeh || weekday > Jomeh {
+		return Time{}, fmt.Errorf("%w %d", ErrInvalidWeekday, weekday)
+	}
+
+	day := weekDay(shamsiToJDN(defaultCalendar, year, int(month), 1), week, weekday)
+	if day < 1 || day > monthDays(year, month) {
+		return Time{}, fmt.Errorf("%w, %s of week %d is not in %s %d", ErrInvalidWeek, weekday, week, month, year)
+	}
+
+	return Date(year, month, day, 0, 0, 0, 0, loc), nil
+}
+
+// weekDay returns the day of a period which begins on the Julian Day Number first,
+// for the weekday of the week, where the first week is the week of the first day.
+func weekDay(first, week int, weekday Weekday) int {
+	return (week-1)*7 + int(weekday-jdnWeekday(first)) + 1
+}
+
+// FormatOrdinalDate returns the ordinal date of t, i.e. the year and the 3-digits day of year, e.g. 1403-045.
+// The year has at least 4 digits and a minus sign if it is negative, e.g. -0005-045.
+func (t Time) FormatOrdinalDate() string {
+	return fmt.Sprintf("%s-%03d", formatYear(t.year), t.YearDay())
+}
+
+// ParseOrdinalDate parses an ordinal date in the format of FormatOrdinalDate and returns the time
+// at 00:00:00 of that day in loc. If loc is nil then the local time is used.
+//
+// Errors are of type *ParseError.
+func ParseOrdinalDate(value string, loc *time.Location) (Time, error) {
+	fail := func(offset int, msg string) (Time, error) {
+		return Time{}, &ParseError{Layout: ordinalDateLayout, Value: value, Offset: offset, Message: msg}
+	}
+
+	year, n, ok := leadingYear(value, true)
+	if !ok {
+		return fail(0, "invalid year")
+	}
+
+	j := n
+	if len(value) < j+1 || value[j] != '-' {
+		return fail(j, `expected "-"`)
+	}
+
+	j++
+	yday, n, ok := leadingInt(value[j:], 3, 3)
+	if !ok {
+		return fail(j, "invalid day of year")
+	}
+
+	t, err := FromYearDay(year, yday, loc)
+	if err != nil {
+		return fail(j, "day of year out of range")
+	}
+
+	if j += n; j < len(value) {
+		return fail(j, "extra text")
+	}
+
+	return t, nil
+}
@@ -0,0 +1,146 @@
+package ptime_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	ptime "github.com/yaa110/go-persian-calendar"
+)
+
+func TestFromYearDay(t *testing.T) {
+	for _, year := range []int{1402, 1403} {
+		ti := ptime.Date(year, ptime.Farvardin, 1, 0, 0, 0, 0, ptime.Iran())
+		for ti.Year() == year {
+			pt, err := ptime.FromYearDay(year, ti.YearDay(), ptime.Iran())
+			if err != nil || pt.String() != ti.String() {
+				t.Fatal(
+					"For", year, ti.YearDay(),
+					"expected", ti,
+					"got", pt, err,
+				)
+			}
+
+			pt, err = ptime.FromYearWeek(year, ti.YearWeek(), ti.Weekday(), ptime.Iran())
+			if err != nil || pt.String() != ti.String() {
+				t.Fatal(
+					"For", year, ti.YearWeek(), ti.Weekday(),
+					"expected", ti,
+					"got", pt, err,
+				)
+			}
+
+			pt, err = ptime.FromMonthWeek(year, ti.Month(), ti.MonthWeek(), ti.Weekday(), ptime.Iran())
+			if err != nil || pt.String() != ti.String() {
+				t.Fatal(
+					"For", year, ti.Month(), ti.MonthWeek(), ti.Weekday(),
+					"expected", ti,
+					"got", pt, err,
+				)
+			}
+
+			ti = ti.Tomorrow()
+		}
+	}
+}
+
+func TestFromYearDayErrors(t *testing.T) {
+	if _, err := ptime.FromYearDay(1402, 366, ptime.Iran()); !errors.Is(err, ptime.ErrInvalidYearDay) {
+		t.Error("Expected", ptime.ErrInvalidYearDay, "got", err)
+	}
+
+	if _, err := ptime.FromYearDay(1403, 0, ptime.Iran()); !errors.Is(err, ptime.ErrInvalidYearDay) {
+		t.Error("Expected", ptime.ErrInvalidYearDay, "got", err)
+	}
+
+	if pt, err := ptime.FromYearDay(1403, 366, ptime.Iran()); err != nil || pt.Month() != ptime.Esfand || pt.Day() != 30 {
+		t.Error("Expected", "1403-12-30", "got", pt, err)
+	}
+
+	// 1 Farvardin 1403 is a Charshanbeh, so the first week of 1403 begins on Charshanbeh.
+	if _, err := ptime.FromYearWeek(1403, 1, ptime.Seshanbeh, ptime.Iran()); !errors.Is(err, ptime.ErrInvalidWeek) {
+		t.Error("Expected", ptime.ErrInvalidWeek, "got", err)
+	}
+
+	if _, err := ptime.FromYearWeek(1403, 1, ptime.Weekday(7), ptime.Iran()); !errors.Is(err, ptime.ErrInvalidWeekday) {
+		t.Error("Expected", ptime.ErrInvalidWeekday, "got", err)
+	}
+
+	if _, err := ptime.FromMonthWeek(1403, ptime.Esfand, 6, ptime.Shanbeh, ptime.Iran()); !errors.Is(err, ptime.ErrInvalidWeek) {
+		t.Error("Expected", ptime.ErrInvalidWeek, "got", err)
+	}
+
+	if _, err := ptime.FromMonthWeek(1403, 13, 1, ptime.Shanbeh, ptime.Iran()); !errors.Is(err, ptime.ErrInvalidMonth) {
+		t.Error("Expected", ptime.ErrInvalidMonth, "got", err)
+	}
+}
+
+func TestOrdinalDate(t *testing.T) {
+	ti := ptime.Date(1403, ptime.Ordibehesht, 14, 12, 0, 0, 0, ptime.Iran())
+
+	if s := ti.FormatOrdinalDate(); s != "1403-045" {
+		t.Error(
+			"Expected", "1403-045",
+			"got", s,
+		)
+	}
+
+	vals := map[string]string{
+		"1403-045": "1403-02-14T00:00:00.0+03:30",
+		"1403-366": "1403-12-30T00:00:00.0+03:30",
+		"۱۴۰۲-۰۰۱": "1402-01-01T00:00:00.0+03:30",
+	}
+
+	for v, s := range vals {
+		pt, err := ptime.ParseOrdinalDate(v, ptime.Iran())
+		if err != nil || pt.String() != s {
+			t.Error(
+				"For", v,
+				"expected", s,
+				"got", pt, err,
+			)
+		}
+	}
+
+	invalid := []string{
+		"1402-366",
+		"1403-000",
+		"1403-45",
+		"1403/045",
+		"1403-0451",
+	}
+
+	for _, v := range invalid {
+		if _, err := ptime.ParseOrdinalDate(v, ptime.Iran()); err == nil {
+			t.Error("Expected an error for", v)
+		}
+	}
+}
+
+func TestOrdinalDateYears(t *testing.T) {
+	vals := map[int]string{
+		-5:    "-0005-045",
+		999:   "0999-045",
+		12000: "12000-045",
+	}
+
+	for year, s := range vals {
+		ti := ptime.Date(year, ptime.Ordibehesht, 14, 0, 0, 0, 0, time.UTC)
+		if f := ti.FormatOrdinalDate(); f != s {
+			t.Error(
+				"For", year,
+				"expected", s,
+				"got", f,
+			)
+		}
+
+		pt, err := ptime.ParseOrdinalDate(s, time.UTC)
+		if err != nil || !pt.Equal(ti) {
+			t.Error(
+				"For", s,
+				"expected", ti,
+				"got", pt, err,
+			)
+		}
+	}
+}
@@ -0,0 +1,708 @@
+package ptime
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ParseError describes a problem parsing a time string.
+type ParseError struct {
+	Layout  string // the layout which was used to parse Value
+	Value   string // the string which was parsed
+	Token   string // the layout element which failed to match
+	Offset  int    // the byte offset of Value at which the match failed
+	Message string // description of the problem, if it is not a plain mismatch
+}
+
+// Error returns the string representation of e.
+func (e *ParseError) Error() string {
+	prefix := "ptime: parsing " + strconv.Quote(e.Value) + " as " + strconv.Quote(e.Layout) + ": "
+	if e.Message != "" {
+		return prefix + e.Message + " at offset " + strconv.Itoa(e.Offset)
+	}
+	return prefix + "cannot parse " + strconv.Quote(e.Value[e.Offset:]) + " as " + strconv.Quote(e.Token)
+}
+
+// parsedTime holds the fields which are collected while parsing a time string.
+type parsedTime struct {
+	year   int
+	month  Month
+	day    int
+	hour   int
+	minute int
+	sec    int
+	nsec   int
+
+	pm      bool // whether the 12-Hour marker is Pm
+	hasAmPm bool // whether a 12-Hour marker was parsed
+	clock12 bool // whether the hour was parsed from a 12-Hour element
+
+	zoneName       string
+	zoneNameOffset int // the offset of the location name in value
+	zoneOffset     int // in seconds east of UTC
+	hasOffset      bool
+
+	dayOffset int    // the offset of the day element in value
+	dayToken  string // the day element of layout
+
+	hasMonth      bool   // whether a month element was parsed
+	quarter       int    // the parsed quarter of year, or 0
+	quarterOffset int    // the offset of the quarter element in value
+	quarterToken  string // the quarter element of layout
+}
+
+func newParsedTime() parsedTime {
+	return parsedTime{month: Farvardin, day: 1}
+}
+
+// Parse parses a formatted string and returns the time value it represents.
+// The layout defines the format by the same elements which are accepted by Format:
+//
+//	yyyy, yyy, y     four digit year (e.g. 1394), with a minus sign if it is negative; more digits are
+//	                 accepted if the next element is a literal which is not a digit (e.g. 12000-01-01)
+//	yy               two digit year, 48-99 is 1348-1399 and 00-47 is 1400-1447
+//	MMM              the Persian name of month (e.g. فروردین)
+//	MMI              the Dari name of month (e.g. حمل)
+//	MM, M            month with and without leading zero
+//	QQQ              the Persian name of season (e.g. بهار)
+//	QQI              the Dari name of season (e.g. خزان)
+//	Q                quarter of year [1-4]
+//	dd, d            day with and without leading zero
+//	E, e             the Persian name and short name of weekday
+//	A, a             the Persian name and short name of 12-Hour marker
+//	HH, H            hour [0-23]
+//	kk, k            hour [1-24]
+//	hh, h            hour [1-12]
+//	KK, K            hour [0-11]
+//	mm, m            minute [0-59]
+//	ss, s            seconds [0-59]
+//	S                3-digits representation of milliseconds
+//	ns               nanoseconds
+//	z                the name of location (e.g. Asia/Tehran)
+//	Z                zone offset (e.g. +03:30)
+//
+// Numbers may be written in any of the digit systems of Digits, so the output of FormatDigits
+// is accepted too. Weekdays, hour names (n), weeks (w, W, rw) and day counts (D, RD, rd) are checked
+// for syntax but otherwise ignored. Elements which are omitted from layout are
+// assumed to be zero, or 1 for the month and day. If the month is omitted, the first month of
+// the quarter or season is used, otherwise they must match.
+//
+// If value has a location name (z), the time is returned in that location.
+// Otherwise, the time is returned in loc, if loc is nil then the local time is used.
+// If value has a zone offset (Z) which is not in effect in the location at that time,
+// the time is returned in a fixed zone with that offset.
+//
+// Errors are of type *ParseError.
+func Parse(layout, value string, loc *time.Location) (Time, error) {
+	p := newParsedTime()
+
+	var i, j int // offsets of layout and value
+
+	fail := func(tok string) (Time, error) {
+		return Time{}, &ParseError{Layout: layout, Value: value, Token: tok, Offset: j}
+	}
+
+	failMsg := func(tok, msg string, offset int) (Time, error) {
+		return Time{}, &ParseError{Layout: layout, Value: value, Token: tok, Offset: offset, Message: msg}
+	}
+
+	for i < len(layout) {
+		tok, n := nextToken(layout[i:])
+		elem := layout[i : i+n]
+		rest := value[j:]
+		i += n
+
+		var (
+			v, m int
+			ok   bool
+		)
+
+		switch tok {
+		case tokenLiteral:
+			if len(rest) < n || rest[:n] != elem {
+				return fail(elem)
+			}
+			m = n
+		case tokenYear:
+			if v, m, ok = leadingYear(rest, wideYear(layout[i:])); !ok {
+				return fail(elem)
+			}
+			p.year = v
+		case tokenYear2:
+			if v, m, ok = leadingInt(rest, 2, 2); !ok {
+				return fail(elem)
+			}
+			p.year = expandYear2(v)
+		case tokenMonthName, tokenMonthDari:
+			names := months[:]
+			if tok == tokenMonthDari {
+				names = dmonths[:]
+			}
+			if v, m = lookup(names, rest); v < 0 {
+				return fail(elem)
+			}
+			p.month, p.hasMonth = Month(v+1), true
+		case tokenSeasonName, tokenSeasonDari:
+			names := seasons[:]
+			if tok == tokenSeasonDari {
+				names = dseasons[:]
+			}
+			if v, m = lookup(names, rest); v < 0 {
+				return fail(elem)
+			}
+			p.quarter, p.quarterOffset, p.quarterToken = v+1, j, elem
+		case tokenQuarter:
+			if v, m, ok = leadingInt(rest, 1, 1); !ok {
+				return fail(elem)
+			}
+			if v < 1 || v > 4 {
+				return failMsg(elem, "quarter out of range", j)
+			}
+			p.quarter, p.quarterOffset, p.quarterToken = v, j, elem
+		case tokenZeroMonth, tokenMonth:
+			if v, m, ok = leadingNumber(rest, tok == tokenZeroMonth); !ok {
+				return fail(elem)
+			}
+			if v < 1 || v > 12 {
+				return failMsg(elem, "month out of range", j)
+			}
+			p.month, p.hasMonth = Month(v), true
+		case tokenZeroDay, tokenDay:
+			if v, m, ok = leadingNumber(rest, tok == tokenZeroDay); !ok {
+				return fail(elem)
+			}
+			p.day, p.dayOffset, p.dayToken = v, j, elem
+		case tokenWeekday:
+			if v, m = lookup(days[:], rest); v < 0 {
+				return fail(elem)
+			}
+		case tokenWeekdayShort:
+			if v, m = lookup(sdays[:], rest); v < 0 {
+				return fail(elem)
+			}
+		case tokenAmPm, tokenAmPmShort:
+			names := amPm[:]
+			if tok == tokenAmPmShort {
+				names = sAmPm[:]
+			}
+			if v, m = lookup(names, rest); v < 0 {
+				return fail(elem)
+			}
+			p.pm, p.hasAmPm = AmPm(v) == Pm, true
+		case tokenZeroHour, tokenHour, tokenZeroHour24, tokenHour24,
+			tokenZeroHour12, tokenHour12, tokenZeroHour11, tokenHour11:
+			zero := tok == tokenZeroHour || tok == tokenZeroHour24 || tok == tokenZeroHour12 || tok == tokenZeroHour11
+			if v, m, ok = leadingNumber(rest, zero); !ok {
+				return fail(elem)
+			}
+
+			lo, hi := 0, 23
+			switch tok { //nolint:exhaustive
+			case tokenZeroHour24, tokenHour24:
+				lo, hi = 1, 24
+			case tokenZeroHour12, tokenHour12:
+				lo, hi = 1, 12
+			case tokenZeroHour11, tokenHour11:
+				hi = 11
+			}
+
+			if v < lo || v > hi {
+				return failMsg(elem, "hour out of range", j)
+			}
+
+			p.hour = v % 24
+			p.clock12 = hi < 23
+		case tokenZeroMinute, tokenMinute:
+			if v, m, ok = leadingNumber(rest, tok == tokenZeroMinute); !ok {
+				return fail(elem)
+			}
+			if v > 59 {
+				return failMsg(elem, "minute out of range", j)
+			}
+			p.minute = v
+		case tokenZeroSecond, tokenSecond:
+			if v, m, ok = leadingNumber(rest, tok == tokenZeroSecond); !ok {
+				return fail(elem)
+			}
+			if v > 59 {
+				return failMsg(elem, "second out of range", j)
+			}
+			p.sec = v
+		case tokenMillisecond:
+			if v, m, ok = leadingInt(rest, 3, 3); !ok {
+				return fail(elem)
+			}
+			p.nsec = v * 1e6
+		case tokenNanosecond:
+			if v, m, ok = leadingInt(rest, 1, 9); !ok {
+				return fail(elem)
+			}
+			p.nsec = v
+		case tokenDayTime:
+			if v, m = lookup(daytimes[:], rest); v < 0 {
+				return fail(elem)
+			}
+		case tokenYearDay, tokenRYearDay, tokenRMonthDay, tokenYearWeek, tokenRYearWeek, tokenMonthWeek:
+			if _, m, ok = leadingInt(rest, 1, 3); !ok {
+				return fail(elem)
+			}
+		case tokenZoneName:
+			m = zoneNameLen(rest)
+			p.zoneName, p.zoneNameOffset = rest[:m], j
+		case tokenZoneOffset:
+			if p.zoneOffset, m, ok = parseZoneOffset(rest, true, true); !ok {
+				return fail(elem)
+			}
+			p.hasOffset = true
+		}
+
+		j += m
+	}
+
+	if j < len(value) {
+		return failMsg("", "extra text "+strconv.Quote(value[j:]), j)
+	}
+
+	if p.quarter > 0 {
+		switch season := Season(p.quarter); {
+		case !p.hasMonth:
+			p.month = season.FirstMonth()
+		case p.month.Season() != season:
+			return failMsg(p.quarterToken, "quarter does not match month", p.quarterOffset)
+		}
+	}
+
+	if msg := p.resolve(); msg != "" {
+		return failMsg(p.dayToken, msg, p.dayOffset)
+	}
+
+	if p.zoneName != "" {
+		l, err := loadLocation(p.zoneName, loc)
+		if err != nil {
+			return failMsg("z", "unknown location "+strconv.Quote(p.zoneName), p.zoneNameOffset)
+		}
+		loc = l
+	}
+
+	return p.time(loc), nil
+}
+
+// resolve checks the day of p against the length of the parsed month and applies the 12-Hour marker.
+// It returns a non-empty message if the day is out of range.
+func (p *parsedTime) resolve() string {
+	i := 0
+	if isLeap(p.year) {
+		i = 1
+	}
+
+	if p.day < 1 || p.day > pMonthCount[p.month-1][i] {
+		return "day out of range"
+	}
+
+	if p.hasAmPm && p.clock12 {
+		switch {
+		case p.pm && p.hour < 12:
+			p.hour += 12
+		case !p.pm && p.hour == 12:
+			p.hour = 0
+		}
+	}
+
+	return ""
+}
+
+// time returns the instance of Time which is represented by p in loc.
+func (p *parsedTime) time(loc *time.Location) Time {
+	if loc == nil {
+		//nolint:gosmopolitan
+		loc = time.Local
+	}
+
+	t := Date(p.year, p.month, p.day, p.hour, p.minute, p.sec, p.nsec, loc)
+	if !p.hasOffset {
+		return t
+	}
+
+	if _, offset := t.Zone(); offset == p.zoneOffset {
+		return t
+	}
+
+	// The parsed offset is not in effect in loc, so use the instant which it represents.
+	gt := Date(p.year, p.month, p.day, p.hour, p.minute, p.sec, p.nsec, time.FixedZone(p.zoneName, p.zoneOffset)).Time()
+	if _, offset := gt.In(loc).Zone(); offset == p.zoneOffset {
+		return New(gt.In(loc))
+	}
+
+	return New(gt)
+}
+
+// loadLocation returns the location with the given name, preferring loc if it has the same name.
+func loadLocation(name string, loc *time.Location) (*time.Location, error) {
+	switch {
+	case loc != nil && loc.String() == name:
+		return loc, nil
+	case name == "UTC":
+		return time.UTC, nil
+	case name == "Local":
+		//nolint:gosmopolitan
+		return time.Local, nil
+	}
+
+	return time.LoadLocation(name)
+}
+
+// expandYear2 returns the four digit year of a two digit year,
+// in the same way as time.Parse maps two digit years to 1969-2068.
+func expandYear2(v int) int {
+	if v >= 48 {
+		return 1300 + v
+	}
+	return 1400 + v
+}
+
+// leadingInt parses at least minDigits and at most maxDigits decimal digits at the beginning of s.
+// The digits may be in any of the digit systems of Digits.
+// It returns the parsed value and the number of bytes consumed.
+func leadingInt(s string, minDigits, maxDigits int) (int, int, bool) {
+	v, n := 0, 0
+	for digits := 0; digits < maxDigits; digits++ {
+		d, size := digitAt(s[n:])
+		if d < 0 {
+			if digits < minDigits {
+				return 0, 0, false
+			}
+			break
+		}
+
+		v = v*10 + d
+		n += size
+	}
+
+	return v, n, true
+}
+
+// maxYearDigits is the number of digits of the widest year in the range [MinYear, MaxYear].
+const maxYearDigits = 7
+
+// leadingYear parses a year of at least 4 digits at the beginning of s, which is preceded by a minus sign
+// if it is negative. The year has at most 4 digits unless wide is set, so that it can be followed by digits.
+func leadingYear(s string, wide bool) (int, int, bool) {
+	maxDigits := 4
+	if wide {
+		maxDigits = maxYearDigits
+	}
+
+	if !strings.HasPrefix(s, "-") {
+		return leadingInt(s, 4, maxDigits)
+	}
+
+	v, n, ok := leadingInt(s[1:], 4, maxDigits)
+	return -v, n + 1, ok
+}
+
+// wideYear reports whether a year element which is followed by layout may have more than 4 digits,
+// i.e. layout is empty or begins with a literal which is not a digit.
+func wideYear(layout string) bool {
+	if layout == "" {
+		return true
+	}
+
+	tok, _ := nextToken(layout)
+	return tok == tokenLiteral && !isDigit(layout[0])
+}
+
+// wideStdYear is like wideYear for the layouts of ParseTimeFormat.
+func wideStdYear(layout string) bool {
+	if layout == "" {
+		return true
+	}
+
+	std, _ := nextStdToken(layout)
+	return std == stdLiteral && !isDigit(layout[0])
+}
+
+// leadingNumber parses a two digit number at the beginning of s.
+// The leading zero is required if zero is set, otherwise the number may have one digit.
+func leadingNumber(s string, zero bool) (int, int, bool) {
+	if zero {
+		return leadingInt(s, 2, 2)
+	}
+	return leadingInt(s, 1, 2)
+}
+
+// lookup returns the index of the longest item of names which is a prefix of s and its length.
+// The index is -1 if there is not any match.
+func lookup(names []string, s string) (int, int) {
+	index, length := -1, 0
+	for i, name := range names {
+		if len(name) > length && len(s) >= len(name) && s[:len(name)] == name {
+			index, length = i, len(name)
+		}
+	}
+	return index, length
+}
+
+// zoneNameLen returns the length of the location name at the beginning of s.
+func zoneNameLen(s string) int {
+	n := 0
+	for ; n < len(s); n++ {
+		c := s[n]
+		if (c < 'a' || c > 'z') && (c < 'A' || c > 'Z') && (c < '0' || c > '9') &&
+			c != '/' && c != '_' && c != '-' && c != '+' {
+			break
+		}
+	}
+	return n
+}
+
+// parseZoneOffset parses a zone offset of the form ±hh, ±hhmm or ±hh:mm at the beginning of s.
+// The minutes are parsed if minutes is set, and separated by a colon if colon is set.
+// It returns the offset in seconds east of UTC and the number of bytes consumed.
+func parseZoneOffset(s string, minutes, colon bool) (int, int, bool) {
+	if len(s) < 3 || (s[0] != '+' && s[0] != '-') {
+		return 0, 0, false
+	}
+
+	h, _, ok := leadingInt(s[1:], 2, 2)
+	if !ok || h > 23 {
+		return 0, 0, false
+	}
+
+	n, m := 3, 0
+	if minutes {
+		if colon {
+			if len(s) <= n || s[n] != ':' {
+				return 0, 0, false
+			}
+			n++
+		}
+
+		if m, _, ok = leadingInt(s[n:], 2, 2); !ok || m > 59 {
+			return 0, 0, false
+		}
+		n += 2
+	}
+
+	offset := h*3600 + m*60
+	if s[0] == '-' {
+		offset = -offset
+	}
+
+	return offset, n, true
+}
+
+// ParseTimeFormat parses a string which is formatted by the standard layout elements
+// of TimeFormat and returns the time value it represents, in the same way as time.Parse.
+//
+// Numbers may be written in any of the digit systems of Digits, so the output of TimeFormatDigits
+// is accepted too. Month names (Jan, January) may be either Persian or Dari, weekdays (Mon, Monday) and
+// hour names (Morning) are checked for syntax but otherwise ignored. Two digit years (06) are
+// expanded and four digit years (2006) may be signed or wider as described in Parse. A fractional
+// second which follows the seconds element is accepted even if the layout does not have it. Z0700 and Z07:00 accept Z for UTC.
+//
+// The location of the returned time is determined as described in Parse.
+//
+// Errors are of type *ParseError.
+func ParseTimeFormat(layout, value string, loc *time.Location) (Time, error) {
+	p := newParsedTime()
+
+	var i, j int // offsets of layout and value
+
+	fail := func(tok string) (Time, error) {
+		return Time{}, &ParseError{Layout: layout, Value: value, Token: tok, Offset: j}
+	}
+
+	failMsg := func(tok, msg string, offset int) (Time, error) {
+		return Time{}, &ParseError{Layout: layout, Value: value, Token: tok, Offset: offset, Message: msg}
+	}
+
+	for i < len(layout) {
+		std, n := nextStdToken(layout[i:])
+		elem := layout[i : i+n]
+		rest := value[j:]
+		i += n
+
+		var (
+			v, m int
+			ok   bool
+		)
+
+		switch std {
+		case stdLiteral:
+			if len(rest) < n || rest[:n] != elem {
+				return fail(elem)
+			}
+			m = n
+		case stdLongYear:
+			if v, m, ok = leadingYear(rest, wideStdYear(layout[i:])); !ok {
+				return fail(elem)
+			}
+			p.year = v
+		case stdYear:
+			if v, m, ok = leadingInt(rest, 2, 2); !ok {
+				return fail(elem)
+			}
+			p.year = expandYear2(v)
+		case stdLongMonth, stdMonth:
+			if v, m = lookup(months[:], rest); v < 0 {
+				if v, m = lookup(dmonths[:], rest); v < 0 {
+					return fail(elem)
+				}
+			}
+			p.month = Month(v + 1)
+		case stdZeroMonth, stdNumMonth:
+			if v, m, ok = leadingNumber(rest, std == stdZeroMonth); !ok {
+				return fail(elem)
+			}
+			if v < 1 || v > 12 {
+				return failMsg(elem, "month out of range", j)
+			}
+			p.month = Month(v)
+		case stdZeroDay, stdUnderDay, stdDay:
+			if std == stdUnderDay && len(rest) > 0 && rest[0] == ' ' {
+				rest = rest[1:]
+				m = 1
+			}
+			var d int
+			if v, d, ok = leadingNumber(rest, std == stdZeroDay); !ok {
+				return fail(elem)
+			}
+			p.day, p.dayOffset, p.dayToken = v, j, elem
+			m += d
+		case stdLongWeekDay:
+			if v, m = lookup(days[:], rest); v < 0 {
+				return fail(elem)
+			}
+		case stdWeekDay:
+			if v, m = lookup(sdays[:], rest); v < 0 {
+				return fail(elem)
+			}
+		case stdDayTime:
+			if v, m = lookup(daytimes[:], rest); v < 0 {
+				return fail(elem)
+			}
+		case stdPM, stdpm:
+			names := amPm[:]
+			if std == stdpm {
+				names = sAmPm[:]
+			}
+			if v, m = lookup(names, rest); v < 0 {
+				return fail(elem)
+			}
+			p.pm, p.hasAmPm = AmPm(v) == Pm, true
+		case stdHour:
+			if v, m, ok = leadingNumber(rest, false); !ok {
+				return fail(elem)
+			}
+			if v > 23 {
+				return failMsg(elem, "hour out of range", j)
+			}
+			p.hour = v
+		case stdZeroHour12, stdHour12:
+			if v, m, ok = leadingNumber(rest, std == stdZeroHour12); !ok {
+				return fail(elem)
+			}
+			if v > 12 {
+				return failMsg(elem, "hour out of range", j)
+			}
+			p.hour, p.clock12 = v, true
+		case stdZeroMinute, stdMinute:
+			if v, m, ok = leadingNumber(rest, std == stdZeroMinute); !ok {
+				return fail(elem)
+			}
+			if v > 59 {
+				return failMsg(elem, "minute out of range", j)
+			}
+			p.minute = v
+		case stdZeroSecond, stdSecond:
+			if v, m, ok = leadingNumber(rest, std == stdZeroSecond); !ok {
+				return fail(elem)
+			}
+			if v > 59 {
+				return failMsg(elem, "second out of range", j)
+			}
+			p.sec = v
+
+			// Accept a fractional second which is not in the layout, as time.Parse does.
+			if next, _ := nextStdToken(layout[i:]); next != stdFracSecond0 && next != stdFracSecond9 &&
+				len(rest) > m+1 && rest[m] == '.' {
+				nsec, _, size := parseFraction(rest[m+1:], 9)
+				if size > 0 {
+					p.nsec = nsec
+					m += 1 + size
+				}
+			}
+		case stdFracSecond0:
+			digits := n - 1
+			if len(rest) < 1 || rest[0] != '.' {
+				return fail(elem)
+			}
+			nsec, d, size := parseFraction(rest[1:], digits)
+			if d != digits {
+				return fail(elem)
+			}
+			p.nsec, m = nsec, 1+size
+		case stdFracSecond9:
+			if len(rest) > 1 && rest[0] == '.' {
+				if nsec, _, size := parseFraction(rest[1:], 9); size > 0 {
+					p.nsec, m = nsec, 1+size
+				}
+			}
+		case stdTZ:
+			m = zoneNameLen(rest)
+			p.zoneName, p.zoneNameOffset = rest[:m], j
+		case stdISO8601TZ, stdISO8601ColonTZ, stdNumTZ, stdNumColonTZ, stdNumShortTZ:
+			if (std == stdISO8601TZ || std == stdISO8601ColonTZ) && len(rest) > 0 && rest[0] == 'Z' {
+				p.zoneName, p.zoneNameOffset, m = "UTC", j, 1
+				break
+			}
+			colon := std == stdISO8601ColonTZ || std == stdNumColonTZ
+			if p.zoneOffset, m, ok = parseZoneOffset(rest, std != stdNumShortTZ, colon); !ok {
+				return fail(elem)
+			}
+			p.hasOffset = true
+		}
+
+		j += m
+	}
+
+	if j < len(value) {
+		return failMsg("", "extra text "+strconv.Quote(value[j:]), j)
+	}
+
+	if msg := p.resolve(); msg != "" {
+		return failMsg(p.dayToken, msg, p.dayOffset)
+	}
+
+	if p.zoneName != "" {
+		l, err := loadLocation(p.zoneName, loc)
+		if err != nil {
+			return failMsg("MST", "unknown location "+strconv.Quote(p.zoneName), p.zoneNameOffset)
+		}
+		loc = l
+	}
+
+	return p.time(loc), nil
+}
+
+// parseFraction parses at most maxDigits digits of a decimal fraction at the beginning of s.
+// It returns the fraction in nanoseconds, the number of digits and the number of bytes consumed.
+func parseFraction(s string, maxDigits int) (int, int, int) {
+	v, n, digits := 0, 0, 0
+	for ; digits < maxDigits; digits++ {
+		d, size := digitAt(s[n:])
+		if d < 0 {
+			break
+		}
+
+		v = v*10 + d
+		n += size
+	}
+
+	for i := digits; i < 9; i++ {
+		v *= 10
+	}
+
+	return v, digits, n
+}
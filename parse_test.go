@@ -0,0 +1,258 @@
+package ptime_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	ptime "github.com/yaa110/go-persian-calendar"
+)
+
+func TestParse(t *testing.T) {
+	ti := ptime.Date(1394, ptime.Mehr, 2, 14, 7, 8, 52065090, ptime.Iran())
+
+	layouts := []string{
+		"yyyy/MM/dd",
+		"yyyy-MM-ddTHH:mm:ss.nsZ",
+		"d MMM yyyy E hh:mm:ss a",
+		"d MMI yy e h:m:s A",
+		"yyyy/M/d kk:mm:ss.S",
+		"yyyy/MM/dd KK:mm a z",
+		"yyyy/MM/dd HH:mm n D w rd",
+	}
+
+	for _, layout := range layouts {
+		s := ti.Format(layout)
+
+		pt, err := ptime.Parse(layout, s, ptime.Iran())
+		if err != nil {
+			t.Error("For", layout, "unexpected error", err)
+			continue
+		}
+
+		if pt.Format(layout) != s {
+			t.Error(
+				"For", layout,
+				"expected", s,
+				"got", pt.Format(layout),
+			)
+		}
+	}
+
+	pt, err := ptime.Parse("yyyy-MM-ddTHH:mm:ss.nsZ", ti.String(), nil)
+	if err != nil || !pt.Equal(ti) {
+		t.Error(
+			"Expected", ti,
+			"got", pt, err,
+		)
+	}
+}
+
+func TestParseLocation(t *testing.T) {
+	pt, err := ptime.Parse("yyyy/MM/dd HH:mm z", "1394/07/02 12:00 Asia/Kabul", ptime.Iran())
+	if err != nil || pt.Location().String() != "Asia/Kabul" {
+		t.Error(
+			"Expected", "Asia/Kabul",
+			"got", pt.Location(), err,
+		)
+	}
+
+	pt, err = ptime.Parse("yyyy/MM/dd HH:mmZ", "1394/07/02 12:00+00:00", ptime.Iran())
+	if err != nil || pt.Hour() != 12 || pt.Location().String() != "" {
+		t.Error(
+			"Expected", "12:00 in a fixed zone",
+			"got", pt, err,
+		)
+	}
+
+	if pt.Unix() != time.Date(2015, time.September, 24, 12, 0, 0, 0, time.UTC).Unix() {
+		t.Error(
+			"Expected", "2015-09-24T12:00:00Z",
+			"got", pt.Time(),
+		)
+	}
+}
+
+func TestParse12Hour(t *testing.T) {
+	vals := map[string]int{
+		"12:30 ق.ظ": 0,
+		"01:30 ق.ظ": 1,
+		"12:30 ب.ظ": 12,
+		"11:30 ب.ظ": 23,
+	}
+
+	for v, h := range vals {
+		pt, err := ptime.Parse("hh:mm a", v, ptime.Iran())
+		if err != nil || pt.Hour() != h {
+			t.Error(
+				"For", v,
+				"expected", h,
+				"got", pt.Hour(), err,
+			)
+		}
+	}
+
+	for _, h := range []int{0, 11, 12, 23} {
+		ti := ptime.Date(1403, ptime.Mehr, 2, h, 0, 0, 0, ptime.Iran())
+
+		for _, layout := range []string{"yyyy/MM/dd hh:mm:ss a", "yyyy/MM/dd KK:mm:ss A"} {
+			s := ti.Format(layout)
+			if pt, err := ptime.Parse(layout, s, ptime.Iran()); err != nil || !pt.Equal(ti) {
+				t.Error(
+					"For", s,
+					"expected", ti,
+					"got", pt, err,
+				)
+			}
+		}
+
+		s := ti.TimeFormat("2006/01/02 03:04:05 PM")
+		if pt, err := ptime.ParseTimeFormat("2006/01/02 03:04:05 PM", s, ptime.Iran()); err != nil || !pt.Equal(ti) {
+			t.Error(
+				"For", s,
+				"expected", ti,
+				"got", pt, err,
+			)
+		}
+	}
+}
+
+func TestParseError(t *testing.T) {
+	tests := []struct {
+		layout, value string
+		token         string
+		offset        int
+	}{
+		{"yyyy/MM/dd", "1394-07-02", "/", 4},
+		{"yyyy/MM/dd", "1394/13/02", "MM", 5},
+		{"yyyy/MM/dd", "1394/07/31", "dd", 8},
+		{"yyyy/MM/dd", "1394/12/30", "dd", 8},
+		{"yyyy/MM/dd", "1394/07/0x", "dd", 8},
+		{"d MMM yyyy", "2 Mehr 1394", "MMM", 2},
+		{"HH:mm", "24:00", "HH", 0},
+		{"HH:mmZ", "12:00+0330", "Z", 5},
+		{"yyyy", "13945678", "", 7},
+		{"yyyyMM", "1394071", "", 6},
+	}
+
+	for _, tt := range tests {
+		_, err := ptime.Parse(tt.layout, tt.value, ptime.Iran())
+
+		var pe *ptime.ParseError
+		if !errors.As(err, &pe) {
+			t.Error("For", tt.value, "expected a ParseError, got", err)
+			continue
+		}
+
+		if pe.Token != tt.token || pe.Offset != tt.offset {
+			t.Error(
+				"For", tt.value,
+				"expected", tt.token, tt.offset,
+				"got", pe.Token, pe.Offset,
+			)
+		}
+	}
+
+	if _, err := ptime.Parse("yyyy/MM/dd", "1395/12/30", ptime.Iran()); err != nil {
+		t.Error("Expected 30 Esfand of a leap year, got", err)
+	}
+}
+
+func TestParseTimeFormat(t *testing.T) {
+	ti := ptime.Date(1394, ptime.Mehr, 2, 14, 7, 8, 52065090, ptime.Iran())
+
+	layouts := []string{
+		"2006/01/02 15:04",
+		"2 Jan 2006",
+		"Monday 02 January 06 03:04:05.000000000 PM -07:00",
+		"Mon _2 1 2006 3:4:5.999999 pm MST",
+		"2006-01-02T15:04:05.999999999Z07:00",
+		"2006-01-02 15:04:05.000 -0700",
+		"2006-01-02 15:04:05.999 Z0700 Morning",
+	}
+
+	for _, layout := range layouts {
+		s := ti.TimeFormat(layout)
+
+		pt, err := ptime.ParseTimeFormat(layout, s, ptime.Iran())
+		if err != nil {
+			t.Error("For", layout, "unexpected error", err)
+			continue
+		}
+
+		if pt.TimeFormat(layout) != s {
+			t.Error(
+				"For", layout,
+				"expected", s,
+				"got", pt.TimeFormat(layout),
+			)
+		}
+	}
+
+	pt, err := ptime.ParseTimeFormat("2006-01-02T15:04:05Z07:00", "1394-07-02T10:37:08.052065090Z", ptime.Iran())
+	if err != nil || !pt.Equal(ti) || pt.Location() != time.UTC {
+		t.Error(
+			"Expected", ti,
+			"got", pt, err,
+		)
+	}
+
+	pt, err = ptime.ParseTimeFormat("2 Jan 2006", "2 میزان 1394", ptime.Afghanistan())
+	if err != nil || pt.Month() != ptime.Mizan || pt.TimeFormat("2 Jan 2006") != "2 میزان 1394" {
+		t.Error(
+			"Expected", "2 میزان 1394",
+			"got", pt, err,
+		)
+	}
+
+	loc := time.FixedZone("", -12600)
+	tn := ptime.Date(1394, ptime.Mehr, 2, 14, 7, 8, 0, loc)
+
+	if s := tn.TimeFormat("-07:00"); s != "-03:30" {
+		t.Error(
+			"Expected", "-03:30",
+			"got", s,
+		)
+	}
+
+	pt, err = ptime.ParseTimeFormat("2006/01/02 15:04:05 -0700", tn.TimeFormat("2006/01/02 15:04:05 -0700"), nil)
+	if err != nil || !pt.Equal(tn) {
+		t.Error(
+			"Expected", tn,
+			"got", pt, err,
+		)
+	}
+}
+
+func TestParseTimeFormatError(t *testing.T) {
+	tests := []struct {
+		layout, value string
+		token         string
+		offset        int
+	}{
+		{"2006/01/02", "1394/1/02", "01", 5},
+		{"2006/01/02", "1394/07/31", "02", 8},
+		{"15:04", "25:00", "15", 0},
+		{"Jan 2006", "Mehr 1394", "Jan", 0},
+		{"15:04:05.000", "12:00:00.12", ".000", 8},
+		{"2006 MST", "1394 Nowhere/City", "MST", 5},
+	}
+
+	for _, tt := range tests {
+		_, err := ptime.ParseTimeFormat(tt.layout, tt.value, ptime.Iran())
+
+		var pe *ptime.ParseError
+		if !errors.As(err, &pe) {
+			t.Error("For", tt.value, "expected a ParseError, got", err)
+			continue
+		}
+
+		if pe.Token != tt.token || pe.Offset != tt.offset {
+			t.Error(
+				"For", tt.value,
+				"expected", tt.token, tt.offset,
+				"got", pe.Token, pe.Offset,
+			)
+		}
+	}
+}
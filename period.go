@@ -0,0 +1,270 @@
+package ptime
+
+import (
+	"errors"
+	"time"
+)
+
+// Layouts of YearMonth.String and Year.String, which are used as their text encodings.
+const (
+	yearMonthLayout = "yyyy-MM"
+	yearLayout      = "yyyy"
+)
+
+// A YearMonth represents a month of a year in Persian calendar, e.g. Mehr 1403.
+// Like CivilDate, the leap years of YearMonth are determined by ArithmeticCalendar.
+//
+// YearMonth values are always normalized, so they can be compared with == and used as map keys.
+// The zero value of YearMonth is not a valid month and is reported by IsZero.
+type YearMonth struct {
+	year  int
+	month Month
+}
+
+// A Year represents a year in Persian calendar, e.g. 1403.
+// Like CivilDate, the leap years of Year are determined by ArithmeticCalendar.
+type Year int
+
+// NewYearMonth returns a new instance of YearMonth.
+// The month value may be outside its usual range and is normalized, e.g. month 13 of 1402 is Farvardin 1403.
+func NewYearMonth(year int, month Month) YearMonth {
+	year, m := norm(year, int(month)-1, 12)
+	return YearMonth{year, Month(m) + 1}
+}
+
+// YearMonth returns the month of t in the location of t.
+// The month is converted to ArithmeticCalendar if t has another calendar.
+func (t Time) YearMonth() YearMonth {
+	return CivilDateOf(t).YearMonth()
+}
+
+// YearMonth returns the month of d.
+func (d CivilDate) YearMonth() YearMonth {
+	return YearMonth{d.year, d.month}
+}
+
+// Year returns the year of m.
+func (m YearMonth) Year() int {
+	return m.year
+}
+
+// Month returns the month of m in the range [1, 12].
+func (m YearMonth) Month() Month {
+	return m.month
+}
+
+// Days returns the number of days in m, e.g. 30 for Esfand of a leap year.
+func (m YearMonth) Days() int {
+	return monthDays(m.year, m.month)
+}
+
+// IsLeap returns true if the year of m is a leap year.
+func (m YearMonth) IsLeap() bool {
+	return isLeap(m.year)
+}
+
+// First returns the first day of m.
+func (m YearMonth) First() CivilDate {
+	return CivilDate{m.year, m.month, 1}
+}
+
+// Last returns the last day of m.
+func (m YearMonth) Last() CivilDate {
+	return CivilDate{m.year, m.month, m.Days()}
+}
+
+// Next returns the month after m.
+func (m YearMonth) Next() YearMonth {
+	return m.AddMonths(1)
+}
+
+// Prev returns the month before m.
+func (m YearMonth) Prev() YearMonth {
+	return m.AddMonths(-1)
+}
+
+// AddMonths returns a new instance of YearMonth representing n months after m.
+func (m YearMonth) AddMonths(n int) YearMonth {
+	return NewYearMonth(m.year, m.month+Month(n))
+}
+
+// Contains reports whether the date of t in the location of t is in m.
+func (m YearMonth) Contains(t Time) bool {
+	return t.YearMonth() == m
+}
+
+// IsZero returns true if m is the zero value of YearMonth.
+func (m YearMonth) IsZero() bool {
+	return m == YearMonth{}
+}
+
+// Dates returns the days of m in order.
+func (m YearMonth) Dates() []CivilDate {
+	dates := make([]CivilDate, m.Days())
+	for i := range dates {
+		dates[i] = CivilDate{m.year, m.month, i + 1}
+	}
+	return dates
+}
+
+// String returns the month in yyyy-MM format, e.g. 1403-07.
+func (m YearMonth) String() string {
+	return m.Format(yearMonthLayout)
+}
+
+// Format returns the formatted representation of the first day of m like CivilDate.Format.
+func (m YearMonth) Format(format string) string {
+	return m.First().Format(format)
+}
+
+// ParseYearMonth parses a formatted string like Parse and returns the month it represents.
+// Any day, time of day or zone in value is validated but otherwise ignored.
+func ParseYearMonth(layout, value string) (YearMonth, error) {
+	t, err := Parse(layout, value, time.UTC)
+	if err != nil {
+		return YearMonth{}, err
+	}
+
+	return t.YearMonth(), nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface. The month is formatted as String does,
+// and the zero YearMonth is encoded as an empty text.
+func (m YearMonth) MarshalText() ([]byte, error) {
+	if m.IsZero() {
+		return []byte{}, nil
+	}
+
+	return []byte(m.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface. The month must be in yyyy-MM format.
+// An empty text is decoded as the zero YearMonth.
+func (m *YearMonth) UnmarshalText(data []byte) error {
+	if len(data) == 0 {
+		*m = YearMonth{}
+		return nil
+	}
+
+	pm, err := ParseYearMonth(yearMonthLayout, string(data))
+	if err != nil {
+		return err
+	}
+
+	*m = pm
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// The month is encoded as a JSON string in the format of MarshalText, and the zero YearMonth as null.
+func (m YearMonth) MarshalJSON() ([]byte, error) {
+	if m.IsZero() {
+		return []byte("null"), nil
+	}
+
+	return []byte(`"` + m.String() + `"`), nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// The month must be a JSON string in the format of MarshalText. JSON null is a no-op.
+func (m *YearMonth) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+
+	if len(data) < 2 || data[0] != '"' || data[len(data)-1] != '"' {
+		return errors.New("ptime: YearMonth.UnmarshalJSON: input is not a JSON string")
+	}
+
+	return m.UnmarshalText(data[1 : len(data)-1])
+}
+
+// Days returns the number of days in y, i.e. 366 for a leap year and 365 otherwise.
+func (y Year) Days() int {
+	return pMonthCount[Esfand-1][2] + monthDays(int(y), Esfand)
+}
+
+// IsLeap returns true if y is a leap year.
+func (y Year) IsLeap() bool {
+	return isLeap(int(y))
+}
+
+// First returns the first day of y, i.e. 1 Farvardin.
+func (y Year) First() CivilDate {
+	return CivilDate{int(y), Farvardin, 1}
+}
+
+// Last returns the last day of y, i.e. 29 or 30 Esfand.
+func (y Year) Last() CivilDate {
+	return CivilDate{int(y), Esfand, monthDays(int(y), Esfand)}
+}
+
+// Next returns the year after y.
+func (y Year) Next() Year {
+	return y + 1
+}
+
+// Prev returns the year before y.
+func (y Year) Prev() Year {
+	return y - 1
+}
+
+// Contains reports whether the date of t in the location of t is in y.
+func (y Year) Contains(t Time) bool {
+	return CivilDateOf(t).year == int(y)
+}
+
+// Months returns the months of y in order.
+func (y Year) Months() []YearMonth {
+	months := make([]YearMonth, 12)
+	for i := range months {
+		months[i] = YearMonth{int(y), Month(i + 1)}
+	}
+	return months
+}
+
+// Dates returns the days of y in order.
+func (y Year) Dates() []CivilDate {
+	dates := make([]CivilDate, 0, y.Days())
+	for _, m := range y.Months() {
+		dates = append(dates, m.Dates()...)
+	}
+	return dates
+}
+
+// String returns the year in yyyy format, e.g. 1403 or 0999.
+func (y Year) String() string {
+	return y.Format(yearLayout)
+}
+
+// Format returns the formatted representation of the first day of y like CivilDate.Format.
+func (y Year) Format(format string) string {
+	return y.First().Format(format)
+}
+
+// ParseYear parses a formatted string like Parse and returns the year it represents.
+// Any month, day, time of day or zone in value is validated but otherwise ignored.
+func ParseYear(layout, value string) (Year, error) {
+	t, err := Parse(layout, value, time.UTC)
+	if err != nil {
+		return 0, err
+	}
+
+	return Year(t.year), nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface. The year is formatted as String does.
+func (y Year) MarshalText() ([]byte, error) {
+	return []byte(y.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface. The year must be in yyyy format.
+func (y *Year) UnmarshalText(data []byte) error {
+	py, err := ParseYear(yearLayout, string(data))
+	if err != nil {
+		return err
+	}
+
+	*y = py
+	return nil
+}
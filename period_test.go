@@ -0,0 +1,271 @@
+package ptime_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	ptime "github.com/yaa110/go-persian-calendar"
+)
+
+func TestYearMonth(t *testing.T) {
+	vals := []struct {
+		m     ptime.YearMonth
+		days  int
+		first string
+		last  string
+	}{
+		{ptime.NewYearMonth(1403, ptime.Shahrivar), 31, "1403-06-01", "1403-06-31"},
+		{ptime.NewYearMonth(1403, ptime.Mehr), 30, "1403-07-01", "1403-07-30"},
+		{ptime.NewYearMonth(1403, ptime.Esfand), 30, "1403-12-01", "1403-12-30"},
+		{ptime.NewYearMonth(1403, 13), 31, "1404-01-01", "1404-01-31"},
+		{ptime.NewYearMonth(1404, 0), 30, "1403-12-01", "1403-12-30"},
+		{ptime.NewYearMonth(1404, ptime.Esfand), 29, "1404-12-01", "1404-12-29"},
+	}
+
+	for _, v := range vals {
+		if v.m.Days() != v.days || v.m.First().String() != v.first || v.m.Last().String() != v.last {
+			t.Error(
+				"For", v.m,
+				"expected", v.days, v.first, v.last,
+				"got", v.m.Days(), v.m.First(), v.m.Last(),
+			)
+		}
+
+		dates := v.m.Dates()
+		if len(dates) != v.days || dates[0] != v.m.First() || dates[len(dates)-1] != v.m.Last() {
+			t.Error(
+				"For", v.m,
+				"expected", v.days, "dates",
+				"got", dates,
+			)
+		}
+	}
+
+	m := ptime.NewYearMonth(1403, ptime.Esfand)
+	if m.Next() != ptime.NewYearMonth(1404, ptime.Farvardin) || m.Next().Prev() != m || m.AddMonths(-24) != ptime.NewYearMonth(1401, ptime.Esfand) {
+		t.Error(
+			"For", m,
+			"expected", "1404-01", m, "1401-12",
+			"got", m.Next(), m.Next().Prev(), m.AddMonths(-24),
+		)
+	}
+
+	if !m.IsLeap() || m.Year() != 1403 || m.Month() != ptime.Esfand {
+		t.Error(
+			"Expected", 1403, ptime.Esfand, true,
+			"got", m.Year(), m.Month(), m.IsLeap(),
+		)
+	}
+
+	ti := ptime.Date(1403, ptime.Esfand, 30, 23, 0, 0, 0, ptime.Iran())
+	if !m.Contains(ti) || m.Next().Contains(ti) || ti.YearMonth() != m || ti.CivilDate().YearMonth() != m {
+		t.Error("Expected", m, "to contain", ti)
+	}
+}
+
+func TestYearMonthFormat(t *testing.T) {
+	m := ptime.NewYearMonth(1403, ptime.Mehr)
+
+	if m.String() != "1403-07" {
+		t.Error(
+			"Expected", "1403-07",
+			"got", m.String(),
+		)
+	}
+
+	if s := m.Format("MMM yyyy"); s != "مهر 1403" {
+		t.Error(
+			"Expected", "مهر 1403",
+			"got", s,
+		)
+	}
+
+	pm, err := ptime.ParseYearMonth("MMM yyyy", "مهر 1403")
+	if err != nil || pm != m {
+		t.Error(
+			"Expected", m,
+			"got", pm, err,
+		)
+	}
+
+	b, err := json.Marshal(struct{ M ptime.YearMonth }{m})
+	if err != nil || string(b) != `{"M":"1403-07"}` {
+		t.Error(
+			"Expected", `{"M":"1403-07"}`,
+			"got", string(b), err,
+		)
+	}
+
+	var got struct{ M ptime.YearMonth }
+	if err := json.Unmarshal(b, &got); err != nil || got.M != m {
+		t.Error(
+			"Expected", m,
+			"got", got.M, err,
+		)
+	}
+
+	if err := json.Unmarshal([]byte(`{"M":"1403-13"}`), &got); err == nil {
+		t.Error("Expected an error for 1403-13")
+	}
+}
+
+func TestYearMonthZero(t *testing.T) {
+	var m ptime.YearMonth
+
+	if !m.IsZero() || ptime.NewYearMonth(1403, ptime.Mehr).IsZero() {
+		t.Error("Expected", true, false, "got", m.IsZero(), ptime.NewYearMonth(1403, ptime.Mehr).IsZero())
+	}
+
+	text, err := m.MarshalText()
+	if err != nil || len(text) != 0 {
+		t.Error(
+			"Expected", "an empty text",
+			"got", string(text), err,
+		)
+	}
+
+	got := ptime.NewYearMonth(1403, ptime.Mehr)
+	if err := got.UnmarshalText(text); err != nil || !got.IsZero() {
+		t.Error(
+			"Expected", m,
+			"got", got, err,
+		)
+	}
+
+	b, err := json.Marshal(struct{ M ptime.YearMonth }{m})
+	if err != nil || string(b) != `{"M":null}` {
+		t.Error(
+			"Expected", `{"M":null}`,
+			"got", string(b), err,
+		)
+	}
+
+	var v struct{ M ptime.YearMonth }
+	if err := json.Unmarshal(b, &v); err != nil || !v.M.IsZero() {
+		t.Error(
+			"Expected", m,
+			"got", v.M, err,
+		)
+	}
+
+	if err := json.Unmarshal([]byte(`{"M":1403}`), &v); err == nil {
+		t.Error("Expected an error for 1403")
+	}
+}
+
+func TestYear(t *testing.T) {
+	vals := []struct {
+		y    ptime.Year
+		days int
+		leap bool
+		last string
+	}{
+		{1399, 366, true, "1399-12-30"},
+		{1402, 365, false, "1402-12-29"},
+		{1403, 366, true, "1403-12-30"},
+		{1404, 365, false, "1404-12-29"},
+	}
+
+	for _, v := range vals {
+		if v.y.Days() != v.days || v.y.IsLeap() != v.leap || v.y.Last().String() != v.last {
+			t.Error(
+				"For", v.y,
+				"expected", v.days, v.leap, v.last,
+				"got", v.y.Days(), v.y.IsLeap(), v.y.Last(),
+			)
+		}
+
+		dates := v.y.Dates()
+		if len(dates) != v.days || dates[0] != v.y.First() || dates[len(dates)-1] != v.y.Last() {
+			t.Error(
+				"For", v.y,
+				"expected", v.days, "dates",
+				"got", len(dates),
+			)
+		}
+
+		for i := 1; i < len(dates); i++ {
+			if dates[i].DaysSince(dates[i-1]) != 1 {
+				t.Error("For", v.y, "expected consecutive dates, got", dates[i-1], dates[i])
+				break
+			}
+		}
+	}
+
+	y := ptime.Year(1403)
+	if y.Next() != 1404 || y.Prev() != 1402 || len(y.Months()) != 12 || y.Months()[6] != ptime.NewYearMonth(1403, ptime.Mehr) {
+		t.Error(
+			"For", y,
+			"expected", 1404, 1402, "12 months",
+			"got", y.Next(), y.Prev(), y.Months(),
+		)
+	}
+
+	if !y.Contains(ptime.Date(1403, ptime.Farvardin, 1, 0, 0, 0, 0, ptime.Iran())) || y.Contains(ptime.Date(1404, ptime.Farvardin, 1, 0, 0, 0, 0, ptime.Iran())) {
+		t.Error("Expected", y, "to contain only its days")
+	}
+
+	if s := y.Format("yy"); s != "03" {
+		t.Error(
+			"Expected", "03",
+			"got", s,
+		)
+	}
+
+	b, err := json.Marshal([]ptime.Year{y})
+	if err != nil || string(b) != `["1403"]` {
+		t.Error(
+			"Expected", `["1403"]`,
+			"got", string(b), err,
+		)
+	}
+
+	var got []ptime.Year
+	if err := json.Unmarshal(b, &got); err != nil || len(got) != 1 || got[0] != y {
+		t.Error(
+			"Expected", y,
+			"got", got, err,
+		)
+	}
+
+	if py, err := ptime.ParseYear("yy", "99"); err != nil || py != 1399 {
+		t.Error(
+			"Expected", 1399,
+			"got", py, err,
+		)
+	}
+}
+
+func TestYearText(t *testing.T) {
+	vals := map[ptime.Year]string{
+		-5:    "-0005",
+		999:   "0999",
+		1403:  "1403",
+		12000: "12000",
+	}
+
+	for y, s := range vals {
+		if y.String() != s {
+			t.Error(
+				"For", int(y),
+				"expected", s,
+				"got", y.String(),
+			)
+		}
+
+		b, err := y.MarshalText()
+		if err != nil {
+			t.Error("For", int(y), "got", err)
+			continue
+		}
+
+		var got ptime.Year
+		if err := got.UnmarshalText(b); err != nil || got != y {
+			t.Error(
+				"For", string(b),
+				"expected", int(y),
+				"got", int(got), err,
+			)
+		}
+	}
+}
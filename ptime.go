@@ -15,7 +15,6 @@ import (
 	"strconv"
 	"strings"
 	"time"
-	"unicode/utf8"
 )
 
 // A Month specifies a month of the year starting from Farvardin = 1.
@@ -30,6 +29,9 @@ type AmPm int
 // A DayTime represents a part of the day based on hour.
 type DayTime int
 
+// A MonthEndPolicy specifies how AddDateWith handles a day which does not exist in the resulting month.
+type MonthEndPolicy int
+
 // A Time represents a moment in time in Persian (Jalali) Calendar.
 type Time struct {
 	year   int
@@ -41,6 +43,7 @@ type Time struct {
 	nsec   int
 	loc    *time.Location
 	wday   Weekday
+	cal    Calendar
 }
 
 // List of months in Persian calendar.
@@ -104,6 +107,19 @@ const (
 	Night
 )
 
+// List of month-end policies.
+const (
+	// Overflow normalizes the day into the next month, e.g. 31 Shahrivar + 1 month is 1 Aban.
+	// This is the behavior of AddDate.
+	Overflow MonthEndPolicy = iota
+	// ClampToMonthEnd uses the last day of the month, e.g. 31 Shahrivar + 1 month is 30 Mehr.
+	ClampToMonthEnd
+	// PreserveMonthEnd maps the last day of a month to the last day of the resulting month,
+	// e.g. 30 Mehr + 1 month is 30 Aban and 29 Esfand + 1 month is 31 Farvardin.
+	// Other days are clamped like ClampToMonthEnd.
+	PreserveMonthEnd
+)
+
 var amPm = [2]string{
 	"قبل از ظهر",
 	"بعد از ظهر",
@@ -211,7 +227,7 @@ func Afghanistan() *time.Location {
 
 // String returns t in RFC3339Nano format.
 func (t Time) String() string {
-	return t.Format("yyyy-MM-ddTHH:mm:ss.nsZ")
+	return t.Format(textLayout)
 }
 
 // Dari returns the Dari name of the month.
@@ -297,34 +313,27 @@ func (d DayTime) String() string {
 
 // New converts Gregorian calendar to Persian calendar and
 //
-// returns a new instance of Time corresponding to the time of t or a zero instance of time if Gregorian year is less than 1097.
+// returns a new instance of Time corresponding to the time of t, or a zero instance of time if t is the zero time.Time
+// or the Persian year is not in the range [MinYear, MaxYear]. Use NewStrict to tell these cases apart.
 //
-// t is an instance of time.Time in Gregorian calendar.
+// t is an instance of time.Time in Gregorian calendar, which is proleptic before October 15, 1582 like the package time.
 func New(t time.Time) Time {
-	if t.Year() < 1097 {
-		return Time{}
-	}
-
-	pt := new(Time)
-	pt.SetTime(t)
+	return NewWith(t, nil)
+}
 
-	return *pt
+// jdn returns the Julian Day Number of the date of t.
+func (t Time) jdn() int {
+	return shamsiToJDN(t.calendar(), t.year, int(t.month), t.day)
 }
 
 // Time converts the Shamsi (Solar Hijri) testDate stored in the Time struct to the corresponding
 // Gregorian testDate and returns it as a Go time.Time object.
 func (t Time) Time() time.Time {
-	var year, month, day int
-
 	// Convert the Shamsi testDate to the corresponding Julian Day Number (JDN)
-	jdn := convertShamsiToJDN(t.year, int(t.month), t.day)
+	jdn := t.jdn()
 
-	// Convert the JDN to a Gregorian testDate
-	if jdn > gregorianReformJulianDay {
-		year, month, day = convertJDNToGregorianPostReform(jdn)
-	} else {
-		year, month, day = convertJDNToGregorianPreReform(jdn)
-	}
+	// Convert the JDN to a Gregorian testDate, which is proleptic before the Gregorian reform like time.Time
+	year, month, day := JDNToGregorian(jdn)
 
 	// Use the location stored in the Time struct, or default to the local time zone
 	loc := t.loc
@@ -344,16 +353,11 @@ func (t Time) Time() time.Time {
 // hour, minute, sec seconds, nsec nanoseconds offsets represent a moment in time.
 //
 // loc is a pointer to time.Location, if loc is nil then the local time is used.
+//
+// The values are normalized like time.Date, and a zero instance of Time is returned if the year
+// is not in the range [MinYear, MaxYear] after normalization like New. Use DateStrict to validate the values.
 func Date(year int, month Month, day, hour, minute, sec, nsec int, loc *time.Location) Time {
-	if loc == nil {
-		//nolint:gosmopolitan
-		loc = time.Local
-	}
-
-	t := new(Time)
-	t.Set(year, month, day, hour, minute, sec, nsec, loc)
-
-	return *t
+	return DateWith(year, month, day, hour, minute, sec, nsec, loc, nil)
 }
 
 // Unix returns a new instance of PersianDate from unix timestamp.
@@ -380,19 +384,13 @@ func (t *Time) SetTime(ti time.Time) {
 	t.minute = ti.Minute()
 	t.hour = ti.Hour()
 	t.loc = ti.Location()
-	t.wday = getWeekday(ti.Weekday())
 
-	var jdn int
-	gy, gmm, gd := ti.Date()
-	gm := int(gmm)
+	gy, gm, gd := ti.Date()
+	jdn := GregorianToJDN(gy, int(gm), gd)
+	t.wday = jdnWeekday(jdn)
 
-	if isAfterGregorianReform(gy, gm, gd) {
-		jdn = convertGregorianPostReformToJDN(gy, gm, gd)
-	} else {
-		jdn = convertGregorianPreReformToJDN(gy, gm, gd)
-	}
-
-	year, month, day = convertJDNToShamsi(jdn)
+	t.cal = t.calendar()
+	year, month, day = jdnToShamsi(t.cal, jdn)
 
 	t.year = year
 	t.month = Month(month)
@@ -424,22 +422,25 @@ func norm(hi, lo, base int) (int, int) {
 	return hi, lo
 }
 
-// norm returns nhi, nlo such that
-//
-//	hi * base + lo == nhi * base + nlo
-//	0 <= nlo < base
-func normDay(hi, lo, base int) (int, int) {
-	if lo < 1 {
-		n := (-lo-1)/base + 1
-		hi -= n
-		lo += n * base
+// normDate normalizes month overflowing into year, then day overflowing into month and year.
+func normDate(cal Calendar, year int, month Month, day int) (int, Month, int) {
+	m := int(month) - 1
+	year, m = norm(year, m, 12)
+
+	if m < 0 {
+		m = 0
+	} else if m > 11 {
+		m = 11
 	}
-	if lo > base {
-		n := lo / base
-		hi += n
-		lo -= n * base
+
+	month = Month(m) + 1
+	if n := monthDaysIn(cal, year, month); day < 1 || day > n {
+		var pm int
+		year, pm, day = jdnToShamsi(cal, shamsiToJDN(cal, year, int(month), 1)+day-1)
+		month = Month(pm)
 	}
-	return hi, lo
+
+	return year, month, day
 }
 
 // Set sets t.
@@ -460,23 +461,9 @@ func (t *Time) Set(year int, month Month, day, hour, minute, sec, nsec int, loc
 	hour, minute = norm(hour, minute, 60)
 	day, hour = norm(day, hour, 24)
 
-	// Normalize month, overflowing into year.
-	m := int(month) - 1
-	year, m = norm(year, m, 12)
-
-	if m < 0 {
-		m = 0
-	} else if m > 11 {
-		m = 11
-	}
+	t.cal = t.calendar()
+	year, month, day = normDate(t.cal, year, month, day)
 
-	if isLeap(year) {
-		m, day = normDay(m, day, pMonthCount[m][1])
-	} else {
-		m, day = normDay(m, day, pMonthCount[m][0])
-	}
-	year, m = norm(year, m, 12)
-	month = Month(m) + 1
 	t.year = year
 	t.month = month
 	t.day = day
@@ -713,6 +700,11 @@ func (t Time) RMonthDay() int {
 	}
 }
 
+// EndOfDay returns a new instance of Time representing the last nanosecond of the day of t.
+func (t Time) EndOfDay() Time {
+	return t.endOf(UnitDay)
+}
+
 // BeginningOfWeek returns a new instance of Time representing the first day of the week of t.
 // The time is reset to 00:00:00.
 func (t Time) BeginningOfWeek() Time {
@@ -741,10 +733,15 @@ func (t Time) LastWeekday() Time {
 	return t.AddDate(0, 0, int(Jomeh-t.wday))
 }
 
+// EndOfWeek returns a new instance of Time representing the last nanosecond of the week of t.
+func (t Time) EndOfWeek() Time {
+	return t.endOf(UnitWeek)
+}
+
 // BeginningOfMonth returns a new instance of Time representing the first day of the month of t.
 // The time is reset to 00:00:00.
 func (t Time) BeginningOfMonth() Time {
-	return Date(t.year, t.month, 1, 0, 0, 0, 0, t.loc)
+	return t.date(t.year, t.month, 1, 0, 0, 0, 0, t.loc)
 }
 
 // FirstMonthDay returns a new instance of Time representing the first day of the month of t.
@@ -753,7 +750,7 @@ func (t Time) FirstMonthDay() Time {
 		return t
 	}
 
-	return Date(t.year, t.month, 1, t.hour, t.minute, t.sec, t.nsec, t.loc)
+	return t.date(t.year, t.month, 1, t.hour, t.minute, t.sec, t.nsec, t.loc)
 }
 
 // LastMonthDay returns a new instance of Time representing the last day of the month of t.
@@ -774,13 +771,30 @@ func (t Time) LastMonthDay() Time {
 	if ld == t.day {
 		return t
 	}
-	return Date(t.year, t.month, ld, t.hour, t.minute, t.sec, t.nsec, t.loc)
+	return t.date(t.year, t.month, ld, t.hour, t.minute, t.sec, t.nsec, t.loc)
+}
+
+// EndOfMonth returns a new instance of Time representing the last nanosecond of the month of t,
+// e.g. 30 Esfand 23:59:59.999999999 in a leap year.
+func (t Time) EndOfMonth() Time {
+	return t.endOf(UnitMonth)
+}
+
+// BeginningOfQuarter returns a new instance of Time representing the first day of the quarter of t.
+// The time is reset to 00:00:00.
+func (t Time) BeginningOfQuarter() Time {
+	return t.date(t.year, t.Season().FirstMonth(), 1, 0, 0, 0, 0, t.loc)
+}
+
+// EndOfQuarter returns a new instance of Time representing the last nanosecond of the quarter of t.
+func (t Time) EndOfQuarter() Time {
+	return t.endOf(UnitQuarter)
 }
 
 // BeginningOfYear returns a new instance of Time representing the first day of the year of t.
 // The time is reset to 00:00:00.
 func (t Time) BeginningOfYear() Time {
-	return Date(t.year, Farvardin, 1, 0, 0, 0, 0, t.loc)
+	return t.date(t.year, Farvardin, 1, 0, 0, 0, 0, t.loc)
 }
 
 // FirstYearDay returns a new instance of Time representing the first day of the year of t.
@@ -788,7 +802,7 @@ func (t Time) FirstYearDay() Time {
 	if t.month == Farvardin && t.day == 1 {
 		return t
 	}
-	return Date(t.year, Farvardin, 1, t.hour, t.minute, t.sec, t.nsec, t.loc)
+	return t.date(t.year, Farvardin, 1, t.hour, t.minute, t.sec, t.nsec, t.loc)
 }
 
 // LastYearDay returns a new instance of Time representing the last day of the year of t.
@@ -801,7 +815,20 @@ func (t Time) LastYearDay() Time {
 	if t.month == Esfand && t.day == ld {
 		return t
 	}
-	return Date(t.year, Esfand, ld, t.hour, t.minute, t.sec, t.nsec, t.loc)
+	return t.date(t.year, Esfand, ld, t.hour, t.minute, t.sec, t.nsec, t.loc)
+}
+
+// EndOfYear returns a new instance of Time representing the last nanosecond of the year of t.
+func (t Time) EndOfYear() Time {
+	return t.endOf(UnitYear)
+}
+
+// endOf returns the last nanosecond of the unit containing t, i.e. a nanosecond before
+// the beginning of the next unit, which is the latest instant of the unit even if the clock
+// is set back or forward at midnight.
+func (t Time) endOf(unit Unit) Time {
+	_, next := t.unitBounds(unit)
+	return next.Add(-time.Nanosecond)
 }
 
 // MonthWeek returns the week of month of t.
@@ -814,9 +841,10 @@ func (t Time) YearWeek() int {
 	return int(math.Ceil(float64(t.YearDay()+int(t.FirstYearDay().Weekday())) / 7.0))
 }
 
-// RYearWeek returns the number of remaining weeks of the year of t.
+// RYearWeek returns the number of remaining weeks of the year of t,
+// i.e. the number of weeks of YearWeek after the week of t.
 func (t Time) RYearWeek() int {
-	return 52 - t.YearWeek()
+	return t.LastYearDay().YearWeek() - t.YearWeek()
 }
 
 // Yesterday returns a new instance of Time representing a day before the day of t.
@@ -831,7 +859,7 @@ func (t Time) Tomorrow() Time {
 
 // Add returns a new instance of Time for t+d.
 func (t Time) Add(d time.Duration) Time {
-	return New(t.Time().Add(d))
+	return t.newTime(t.Time().Add(d))
 }
 
 // AddDate returns a new instance of Time for t.year+years, t.month+months and t.day+days.
@@ -840,23 +868,79 @@ func (t Time) AddDate(years, months, days int) Time {
 	return t
 }
 
+// AddDateWith returns a new instance of Time for t.year+years, t.month+months and t.day+days like AddDate,
+// but policy specifies how a day which does not exist in the resulting month is handled.
+// The years and months are added first, then the policy is applied and finally the days are added.
+func (t Time) AddDateWith(years, months, days int, policy MonthEndPolicy) Time {
+	if policy == Overflow {
+		return t.AddDate(years, months, days)
+	}
+
+	year, m := norm(t.year+years, int(t.month)-1+months, 12)
+	month := Month(m) + 1
+
+	day := t.day
+	if n := monthDaysIn(t.calendar(), year, month); day > n || (policy == PreserveMonthEnd && t.isMonthEnd()) {
+		day = n
+	}
+
+	t.Set(year, month, day+days, t.hour, t.minute, t.sec, t.nsec, t.loc)
+	return t
+}
+
+// AddMonths returns a new instance of Time for t.month+months, policy specifies how a day
+// which does not exist in the resulting month is handled. See AddDateWith.
+func (t Time) AddMonths(months int, policy MonthEndPolicy) Time {
+	return t.AddDateWith(0, months, 0, policy)
+}
+
+// isMonthEnd reports whether t is the last day of its month.
+func (t Time) isMonthEnd() bool {
+	return t.month >= Farvardin && t.month <= Esfand && t.day == monthDaysIn(t.calendar(), t.year, t.month)
+}
+
 // Since returns the number of seconds between t and t2.
+//
+// Deprecated: Since drops the sign and the fractions of a second.
+// Use t2.Sub(t) or the function Since instead.
 func (t Time) Since(t2 Time) int64 {
 	return int64(math.Abs(float64(t2.Unix() - t.Unix())))
 }
 
-// IsLeap returns true if the year of t is a leap year.
+// Sub returns the duration t-u. If the result exceeds the maximum (or minimum)
+// value that can be stored in a time.Duration, the maximum (or minimum) duration will be returned.
+func (t Time) Sub(u Time) time.Duration {
+	return t.Time().Sub(u.Time())
+}
+
+// Since returns the time elapsed since t. It is shorthand for Now().Sub(t).
+func Since(t Time) time.Duration {
+	return time.Since(t.Time())
+}
+
+// Until returns the duration until t. It is shorthand for t.Sub(Now()).
+func Until(t Time) time.Duration {
+	return time.Until(t.Time())
+}
+
+// IsLeap returns true if the year of t is a leap year in the calendar of t.
 func (t Time) IsLeap() bool {
-	return isLeap(t.year)
+	return isLeapIn(t.calendar(), t.year)
 }
 
+// isLeap reports whether year is a leap year in ArithmeticCalendar.
 func isLeap(year int) bool {
-	return divider(25*year+11, 33) < 8
+	return isLeapIn(defaultCalendar, year)
+}
+
+// monthDays returns the number of days of month in year in ArithmeticCalendar, month must be in the range [1, 12].
+func monthDays(year int, month Month) int {
+	return monthDaysIn(defaultCalendar, year, month)
 }
 
-// AmPm returns the 12-Hour marker of t.
+// AmPm returns the 12-Hour marker of t, which is Pm from 12:00:00 (noon) to 23:59:59.
 func (t Time) AmPm() AmPm {
-	if t.hour > 12 || (t.hour == 12 && (t.minute > 0 || t.sec > 0)) {
+	if t.hour >= 12 {
 		return Pm
 	}
 	return Am
@@ -893,16 +977,22 @@ func (t Time) ZoneOffset(f ...string) string {
 		}
 	}
 
+	sign := '+'
+	if offset < 0 {
+		sign = '-'
+		offset = -offset
+	}
+
 	h := offset / 3600
 	m := (offset - h*3600) / 60
 
 	switch format {
 	case "-0700", "Z0700":
-		return fmt.Sprintf("%+03d%02d", h, m)
+		return fmt.Sprintf("%c%02d%02d", sign, h, m)
 	case "-07":
-		return fmt.Sprintf("%+03d", h)
+		return fmt.Sprintf("%c%02d", sign, h)
 	default:
-		return fmt.Sprintf("%+03d:%02d", h, m)
+		return fmt.Sprintf("%c%02d:%02d", sign, h, m)
 	}
 }
 
@@ -914,6 +1004,9 @@ func (t Time) ZoneOffset(f ...string) string {
 //	MMI              the Dari name of month (e.g. حمل)
 //	MM               2-digits representation of month (e.g. 01)
 //	M                month (e.g. 1)
+//	QQQ              the Persian name of season (e.g. بهار)
+//	QQI              the Dari name of season (e.g. خزان)
+//	Q                quarter of year [1-4]
 //	rw               remaining weeks of year
 //	w                week of year
 //	RW               remaining weeks of month
@@ -945,6 +1038,12 @@ func (t Time) ZoneOffset(f ...string) string {
 //	z                the name of location
 //	Z                zone offset (e.g. +03:30)
 func (t Time) Format(format string) string {
+	return t.FormatDigits(format, LatinDigits)
+}
+
+// FormatDigits returns the formatted representation of t like Format,
+// but the numeric fields are written in digits. Zone offsets are always written in Latin digits.
+func (t Time) FormatDigits(format string, digits Digits) string {
 	if format == "" {
 		return ""
 	}
@@ -956,212 +1055,146 @@ func (t Time) Format(format string) string {
 
 	sb.Grow(2 * len(format)) // double the format len, the formatted value likely to be longer than format
 
+	writeNum := func(s string) {
+		sb.WriteString(digits.convert(s))
+	}
+
 	writeD2 := func(v int) {
 		if v < 10 {
-			sb.WriteByte('0')
+			writeNum("0")
 		}
 
-		sb.WriteString(strconv.Itoa(v))
+		writeNum(strconv.Itoa(v))
 	}
 
 	writeD3 := func(v int) {
 		switch {
 		case v >= 100: // noop
 		case v >= 10:
-			sb.WriteByte('0')
+			writeNum("0")
 		case v >= 0:
-			sb.WriteString("00")
+			writeNum("00")
 		}
 
-		sb.WriteString(strconv.Itoa(v))
+		writeNum(strconv.Itoa(v))
 	}
 
 	writeD4 := func(v int) {
+		if v < 0 {
+			sb.WriteByte('-')
+			v = -v
+		}
+
 		switch {
 		case v >= 1000: // noop
 		case v >= 100:
-			sb.WriteByte('0')
+			writeNum("0")
 		case v >= 10:
-			sb.WriteString("00")
+			writeNum("00")
 		case v >= 0:
-			sb.WriteString("000")
+			writeNum("000")
 		}
 
-		sb.WriteString(strconv.Itoa(v))
+		writeNum(strconv.Itoa(v))
 	}
 
-	// peek returns next character
-	peek := func() byte {
-		j := i + 1
+	for i < len(format) {
+		tok, n := nextToken(format[i:])
 
-		if j < 0 || j >= len(format) { // IsInBounds()
-			return 0
-		}
-
-		return format[j]
-	}
-
-	for i >= 0 && i < len(format) {
-		current := format[i:]
-
-		switch format[i] {
-		case 'A':
+		switch tok {
+		case tokenLiteral:
+			sb.WriteString(format[i : i+n])
+		case tokenAmPm:
 			sb.WriteString(t.AmPm().String())
-			i++
-		case 'D':
-			sb.WriteString(strconv.Itoa(t.YearDay()))
-			i++
-		case 'E':
-			sb.WriteString(t.wday.String())
-			i++
-		case 'H':
-			if peek() == 'H' { // HH
-				writeD2(t.hour)
-				i += 2
-			} else { // H
-				sb.WriteString(strconv.Itoa(t.hour))
-				i++
-			}
-		case 'K':
-			if peek() == 'K' { // KK
-				writeD2(t.Hour12())
-				i += 2
-			} else { // K
-				sb.WriteString(strconv.Itoa(t.Hour12()))
-				i++
-			}
-		case 'M':
-			switch {
-			default: // M
-				sb.WriteString(strconv.Itoa(int(t.month)))
-				i++
-			case strings.HasPrefix(current, "MMM"):
-				sb.WriteString(t.month.String())
-				i += 3
-			case strings.HasPrefix(current, "MMI"):
-				sb.WriteString(t.month.Dari())
-				i += 3
-			case peek() == 'M': // MM
-				writeD2(int(t.month))
-				i += 2
-			}
-		case 'R':
-			if peek() == 'D' { // RD
-				sb.WriteString(strconv.Itoa(t.RYearDay()))
-				i += 2
-			} else { // R
-				sb.WriteByte('R')
-				i++
-			}
-		case 'S':
-			writeD3(t.nsec / 1e6)
-			i++
-		case 'W':
-			sb.WriteString(strconv.Itoa(t.MonthWeek()))
-			i++
-		case 'Z':
-			sb.WriteString(t.ZoneOffset())
-			i++
-		case 'a':
+		case tokenAmPmShort:
 			sb.WriteString(t.AmPm().Short())
-			i++
-		case 'd':
-			if peek() == 'd' { // dd
-				writeD2(t.day)
-				i += 2
-			} else { // d
-				sb.WriteString(strconv.Itoa(t.day))
-				i++
-			}
-		case 'e':
+		case tokenYearDay:
+			writeNum(strconv.Itoa(t.YearDay()))
+		case tokenRYearDay:
+			writeNum(strconv.Itoa(t.RYearDay()))
+		case tokenRMonthDay:
+			writeNum(strconv.Itoa(t.RMonthDay()))
+		case tokenYearWeek:
+			writeNum(strconv.Itoa(t.YearWeek()))
+		case tokenRYearWeek:
+			writeNum(strconv.Itoa(t.RYearWeek()))
+		case tokenMonthWeek:
+			writeNum(strconv.Itoa(t.MonthWeek()))
+		case tokenWeekday:
+			sb.WriteString(t.wday.String())
+		case tokenWeekdayShort:
 			sb.WriteString(t.wday.Short())
-			i++
-		case 'h':
-			if peek() == 'h' { // hh
-				writeD2(modifyHour(t.Hour12(), 12))
-				i += 2
-			} else { // h
-				sb.WriteString(strconv.Itoa(modifyHour(t.Hour12(), 12)))
-				i++
-			}
-		case 'k':
-			if peek() == 'k' { // kk
-				writeD2(modifyHour(t.hour, 24))
-				i += 2
-			} else { // k
-				sb.WriteString(strconv.Itoa(modifyHour(t.hour, 24)))
-				i++
-			}
-		case 'm':
-			if peek() == 'm' { // mm
-				writeD2(t.minute)
-				i += 2
-			} else { // m
-				sb.WriteString(strconv.Itoa(t.minute))
-				i++
-			}
-		case 'n':
-			if peek() == 's' { // ns
-				sb.WriteString(strconv.Itoa(t.nsec))
-				i += 2
-			} else { // n
-				sb.WriteString(t.DayTime().String())
-				i++
-			}
-		case 'r':
-			switch peek() {
-			default: // r
-				sb.WriteByte('r')
-				i++
-			case 'w': // rw
-				sb.WriteString(strconv.Itoa(t.RYearWeek()))
-				i += 2
-			case 'd': // rd
-				sb.WriteString(strconv.Itoa(t.RMonthDay()))
-				i += 2
-			}
-		case 's':
-			if peek() == 's' { // ss
-				writeD2(t.sec)
-				i += 2
-			} else { // s
-				sb.WriteString(strconv.Itoa(t.sec))
-				i++
+		case tokenZeroHour:
+			writeD2(t.hour)
+		case tokenHour:
+			writeNum(strconv.Itoa(t.hour))
+		case tokenZeroHour11:
+			writeD2(t.Hour12())
+		case tokenHour11:
+			writeNum(strconv.Itoa(t.Hour12()))
+		case tokenZeroHour12:
+			writeD2(modifyHour(t.Hour12(), 12))
+		case tokenHour12:
+			writeNum(strconv.Itoa(modifyHour(t.Hour12(), 12)))
+		case tokenZeroHour24:
+			writeD2(modifyHour(t.hour, 24))
+		case tokenHour24:
+			writeNum(strconv.Itoa(modifyHour(t.hour, 24)))
+		case tokenMonthName:
+			sb.WriteString(t.month.String())
+		case tokenMonthDari:
+			sb.WriteString(t.month.Dari())
+		case tokenSeasonName:
+			sb.WriteString(t.Season().String())
+		case tokenSeasonDari:
+			sb.WriteString(t.Season().Dari())
+		case tokenQuarter:
+			writeNum(strconv.Itoa(t.Quarter()))
+		case tokenZeroMonth:
+			writeD2(int(t.month))
+		case tokenMonth:
+			writeNum(strconv.Itoa(int(t.month)))
+		case tokenZeroDay:
+			writeD2(t.day)
+		case tokenDay:
+			writeNum(strconv.Itoa(t.day))
+		case tokenZeroMinute:
+			writeD2(t.minute)
+		case tokenMinute:
+			writeNum(strconv.Itoa(t.minute))
+		case tokenZeroSecond:
+			writeD2(t.sec)
+		case tokenSecond:
+			writeNum(strconv.Itoa(t.sec))
+		case tokenMillisecond:
+			writeD3(t.nsec / 1e6)
+		case tokenNanosecond:
+			writeNum(strconv.Itoa(t.nsec))
+		case tokenDayTime:
+			sb.WriteString(t.DayTime().String())
+		case tokenYear:
+			writeD4(t.year)
+		case tokenYear2:
+			year := t.year
+			if year < 0 {
+				year = -year
 			}
-		case 'w':
-			sb.WriteString(strconv.Itoa(t.YearWeek()))
-			i++
-		case 'y':
-			switch {
-			default: // y
-				writeD4(t.year)
-				i++
-			case strings.HasPrefix(current, "yyyy"):
-				writeD4(t.year)
-				i += 4
-			case strings.HasPrefix(current, "yyy"):
-				writeD4(t.year)
-				i += 3
-			case peek() == 'y': // yy
-				switch s := strconv.Itoa(t.year); len(s) {
-				default:
-					sb.WriteString(s[len(s)-2:])
-				case 1:
-					sb.WriteString("0" + s)
-				case 2:
-					sb.WriteString(s)
-				}
-
-				i += 2
+
+			switch s := strconv.Itoa(year); len(s) {
+			default:
+				writeNum(s[len(s)-2:])
+			case 1:
+				writeNum("0" + s)
+			case 2:
+				writeNum(s)
 			}
-		case 'z':
+		case tokenZoneName:
 			sb.WriteString(t.loc.String())
-			i++
-		default:
-			r, n := utf8.DecodeRuneInString(current)
-			sb.WriteRune(r)
-			i += n
+		case tokenZoneOffset:
+			sb.WriteString(t.ZoneOffset())
 		}
+
+		i += n
 	}
 
 	return sb.String()
@@ -1203,93 +1236,96 @@ func (t Time) Format(format string) string {
 //	Z0700       zone offset (e.g. +0330)
 //	Z07:00      zone offset (e.g. +03:30)
 func (t Time) TimeFormat(format string) string {
-	initializer := []string{
-		"January", "{MMMM}",
-		"Jan", "{MMM}",
-		"Monday", "{WD}",
-		"Mon", "{W}",
-		"Morning", "{n}",
-		".000000000", "{ns}",
-		".000000", "{ms}",
-		".000", "{mls}",
-		".999999999", "{nst}",
-		".999999", "{mst}",
-		".999", "{mlst}",
-		"2006", "{YYYY}",
-		"PM", "{AFTER}",
-		"pm", "{after}",
-		"MST", "{LOC}",
-		"Z0700", "{Z0700}",
-		"Z07:00", "{Z07:00}",
-		"-0700", "{-0700}",
-		"-07:00", "{-07:00}",
-		"-07", "{-07}",
-		"15", "{HH}",
-		"06", "{YY}",
-		"01", "{MM}",
-		"02", "{DD}",
-		"03", "{hh}",
-		"04", "{mm}",
-		"05", "{ss}",
-		"_2", "{_D}",
-		"1", "{M}",
-		"2", "{D}",
-		"3", "{h}",
-		"4", "{m}",
-		"5", "{s}",
-	}
+	return t.TimeFormatDigits(format, LatinDigits)
+}
 
-	r := strings.NewReplacer(initializer...)
-	formatted := r.Replace(format)
+// TimeFormatDigits formats in standard time format like TimeFormat,
+// but the numeric fields are written in digits. Zone offsets are always written in Latin digits.
+func (t Time) TimeFormatDigits(format string, digits Digits) string {
+	var sb strings.Builder
 
-	nsec := strconv.Itoa(t.nsec)
-	if len(nsec) < 6 {
-		nsec = fmt.Sprintf("%06d", t.nsec)
-	}
+	sb.Grow(2 * len(format)) // double the format len, the formatted value likely to be longer than format
 
-	year := strconv.Itoa(t.year)
-	if len(year) < 4 {
-		year = fmt.Sprintf("%04d", t.year)
+	writeNum := func(s string) {
+		sb.WriteString(digits.convert(s))
 	}
 
-	params := []string{
-		"{YYYY}", year,
-		"{YY}", year[2:],
-		"{MMMM}", t.locMonthName(),
-		"{MMM}", t.locMonthName(),
-		"{MM}", fmt.Sprintf("%02d", int(t.month)),
-		"{M}", strconv.Itoa(int(t.month)),
-		"{DD}", fmt.Sprintf("%02d", t.day),
-		"{_D}", fmt.Sprintf("%2d", t.day),
-		"{D}", strconv.Itoa(t.day),
-		"{WD}", t.wday.String(),
-		"{W}", t.wday.Short(),
-		"{n}", t.DayTime().String(),
-		"{HH}", fmt.Sprintf("%02d", t.hour),
-		"{hh}", fmt.Sprintf("%02d", t.Hour12()),
-		"{h}", strconv.Itoa(t.Hour12()),
-		"{mm}", fmt.Sprintf("%02d", t.minute),
-		"{m}", strconv.Itoa(t.minute),
-		"{ss}", fmt.Sprintf("%02d", t.sec),
-		"{s}", strconv.Itoa(t.sec),
-		"{ns}", "." + nsec,
-		"{ms}", "." + nsec[:6],
-		"{mls}", "." + nsec[:3],
-		"{nst}", strings.TrimRight("."+nsec, "0"),
-		"{mst}", strings.TrimRight("."+nsec[:6], "0"),
-		"{mlst}", strings.TrimRight("."+nsec[:3], "0"),
-		"{AFTER}", t.AmPm().String(),
-		"{after}", t.AmPm().Short(),
-		"{LOC}", t.loc.String(),
-		"{Z0700}", t.ZoneOffset("Z0700"),
-		"{Z07:00}", t.ZoneOffset("Z07:00"),
-		"{-0700}", t.ZoneOffset("-0700"),
-		"{-07:00}", t.ZoneOffset("-07:00"),
-		"{-07}", t.ZoneOffset("-07"),
+	nsec := fmt.Sprintf("%09d", t.nsec)
+
+	year := formatYear(t.year)
+
+	for i := 0; i < len(format); {
+		std, n := nextStdToken(format[i:])
+
+		switch std {
+		case stdLiteral:
+			sb.WriteString(format[i : i+n])
+		case stdLongMonth, stdMonth:
+			sb.WriteString(t.locMonthName())
+		case stdLongWeekDay:
+			sb.WriteString(t.wday.String())
+		case stdWeekDay:
+			sb.WriteString(t.wday.Short())
+		case stdDayTime:
+			sb.WriteString(t.DayTime().String())
+		case stdFracSecond0:
+			sb.WriteByte('.')
+			writeNum(nsec[:n-1])
+		case stdFracSecond9:
+			if frac := strings.TrimRight(nsec[:n-1], "0"); frac != "" {
+				sb.WriteByte('.')
+				writeNum(frac)
+			}
+		case stdLongYear:
+			writeNum(year)
+		case stdYear:
+			writeNum(year[len(year)-2:])
+		case stdPM:
+			sb.WriteString(t.AmPm().String())
+		case stdpm:
+			sb.WriteString(t.AmPm().Short())
+		case stdTZ:
+			sb.WriteString(t.loc.String())
+		case stdISO8601TZ:
+			sb.WriteString(t.ZoneOffset("Z0700"))
+		case stdISO8601ColonTZ:
+			sb.WriteString(t.ZoneOffset("Z07:00"))
+		case stdNumTZ:
+			sb.WriteString(t.ZoneOffset("-0700"))
+		case stdNumColonTZ:
+			sb.WriteString(t.ZoneOffset("-07:00"))
+		case stdNumShortTZ:
+			sb.WriteString(t.ZoneOffset("-07"))
+		case stdHour:
+			writeNum(fmt.Sprintf("%02d", t.hour))
+		case stdZeroMonth:
+			writeNum(fmt.Sprintf("%02d", int(t.month)))
+		case stdNumMonth:
+			writeNum(strconv.Itoa(int(t.month)))
+		case stdZeroDay:
+			writeNum(fmt.Sprintf("%02d", t.day))
+		case stdUnderDay:
+			writeNum(fmt.Sprintf("%2d", t.day))
+		case stdDay:
+			writeNum(strconv.Itoa(t.day))
+		case stdZeroHour12:
+			writeNum(fmt.Sprintf("%02d", t.Hour12()))
+		case stdHour12:
+			writeNum(strconv.Itoa(t.Hour12()))
+		case stdZeroMinute:
+			writeNum(fmt.Sprintf("%02d", t.minute))
+		case stdMinute:
+			writeNum(strconv.Itoa(t.minute))
+		case stdZeroSecond:
+			writeNum(fmt.Sprintf("%02d", t.sec))
+		case stdSecond:
+			writeNum(strconv.Itoa(t.sec))
+		}
+
+		i += n
 	}
 
-	r = strings.NewReplacer(params...)
-	return r.Replace(formatted)
+	return sb.String()
 }
 
 func (t *Time) locMonthName() string {
@@ -1344,6 +1380,14 @@ func (t *Time) normDay() {
 	between(&t.day, 1, pMonthCount[m][i])
 }
 
+// formatYear returns the year in decimal of at least 4 digits, with a minus sign if it is negative.
+func formatYear(year int) string {
+	if year < 0 {
+		return fmt.Sprintf("-%04d", -year)
+	}
+	return fmt.Sprintf("%04d", year)
+}
+
 func modifyHour(value, maxHour int) int {
 	if value == 0 {
 		return maxHour
@@ -1374,26 +1418,15 @@ func divider(num, den int) int {
 	return num - ((((num + 1) / den) - 1) * den)
 }
 
-func getWeekday(wd time.Weekday) Weekday {
-	switch wd {
-	case time.Saturday:
-		return Shanbeh
-	case time.Sunday:
-		return Yekshanbeh
-	case time.Monday:
-		return Doshanbeh
-	case time.Tuesday:
-		return Seshanbeh
-	case time.Wednesday:
-		return Charshanbeh
-	case time.Thursday:
-		return Panjshanbeh
-	case time.Friday:
-		return Jomeh
+// mod returns the remainder of num divided by den in the range [0, den).
+func mod(num, den int) int {
+	m := num % den
+	if m < 0 {
+		m += den
 	}
-	return 0
+	return m
 }
 
 func (t *Time) resetWeekday() {
-	t.wday = getWeekday(t.Time().Weekday())
+	t.wday = jdnWeekday(t.jdn())
 }
@@ -151,6 +151,10 @@ var dayFunctionsSlice = []dayFunctions{
 		pdate{1395, ptime.Ordibehesht, 12},
 		pdate{1395, ptime.Ordibehesht, 13},
 	},
+	{
+		pdate{1395, ptime.Shahrivar, 31},
+		pdate{1395, ptime.Mehr, 1},
+	},
 }
 
 var daytimes = []dayTime{
@@ -478,6 +482,43 @@ func TestFirstLast(t *testing.T) {
 	}
 }
 
+func TestEndOf(t *testing.T) {
+	ti := ptime.Date(1403, ptime.Bahman, 17, 15, 4, 5, 6, ptime.Iran()) // Chaharshanbeh
+
+	vals := []struct {
+		t ptime.Time
+		s string
+	}{
+		{ti.EndOfDay(), "1403-11-17T23:59:59.999999999+03:30"},
+		{ti.EndOfWeek(), "1403-11-19T23:59:59.999999999+03:30"},
+		{ti.EndOfMonth(), "1403-11-30T23:59:59.999999999+03:30"},
+		{ti.EndOfQuarter(), "1403-12-30T23:59:59.999999999+03:30"},
+		{ti.EndOfYear(), "1403-12-30T23:59:59.999999999+03:30"},
+		{ti.AddDate(-1, 0, 0).EndOfYear(), "1402-12-29T23:59:59.999999999+03:30"},
+		{ti.AddDate(0, -6, 0).EndOfQuarter(), "1403-06-31T23:59:59.999999999+03:30"},
+	}
+
+	for _, v := range vals {
+		if v.t.String() != v.s {
+			t.Error(
+				"Expected", v.s,
+				"got", v.t,
+			)
+		}
+	}
+
+	// The clock in Tehran was set back from 24:00 to 23:00 on 30 Shahrivar 1400,
+	// so the last nanosecond of the day is the second 23:59:59.999999999.
+	dst := ptime.Date(1400, ptime.Shahrivar, 30, 12, 0, 0, 0, ptime.Iran())
+	if end := dst.EndOfDay(); end.String() != "1400-06-30T23:59:59.999999999+03:30" ||
+		end.Add(time.Nanosecond).String() != "1400-06-31T00:00:00.0+03:30" {
+		t.Error(
+			"Expected", "1400-06-30T23:59:59.999999999+03:30",
+			"got", end,
+		)
+	}
+}
+
 func TestAddDate(t *testing.T) {
 	ti := ptime.Date(1394, ptime.Mehr, 2, 12, 59, 59, 0, ptime.Iran())
 
@@ -497,6 +538,14 @@ func TestAddDate(t *testing.T) {
 		)
 	}
 
+	if d := ptime.Date(1394, ptime.Shahrivar, 1, 0, 0, 0, 0, ptime.Iran()).AddDate(0, 0, 70); d.Month() != ptime.Aban || d.Day() != 10 {
+		t.Error(
+			"For", "AddDate(0, 0, 70)",
+			"expected", "1394/08/10",
+			"got", d,
+		)
+	}
+
 	if ti.AddDate(2, 0, 0).Weekday() != ptime.Yekshanbeh {
 		t.Error(
 			"For", "AddDate(2, 0, 0).Weekday()",
@@ -506,6 +555,58 @@ func TestAddDate(t *testing.T) {
 	}
 }
 
+func TestAddDateWith(t *testing.T) {
+	tests := []struct {
+		from     pdate
+		years    int
+		months   int
+		days     int
+		policy   ptime.MonthEndPolicy
+		expected pdate
+	}{
+		{pdate{1403, ptime.Shahrivar, 31}, 0, 1, 0, ptime.Overflow, pdate{1403, ptime.Aban, 1}},
+		{pdate{1403, ptime.Shahrivar, 31}, 0, 1, 0, ptime.ClampToMonthEnd, pdate{1403, ptime.Mehr, 30}},
+		{pdate{1403, ptime.Shahrivar, 31}, 0, 1, 0, ptime.PreserveMonthEnd, pdate{1403, ptime.Mehr, 30}},
+		{pdate{1403, ptime.Mehr, 30}, 0, 1, 0, ptime.ClampToMonthEnd, pdate{1403, ptime.Aban, 30}},
+		{pdate{1403, ptime.Mehr, 30}, 0, -1, 0, ptime.ClampToMonthEnd, pdate{1403, ptime.Shahrivar, 30}},
+		{pdate{1403, ptime.Mehr, 30}, 0, -1, 0, ptime.PreserveMonthEnd, pdate{1403, ptime.Shahrivar, 31}},
+		{pdate{1402, ptime.Esfand, 29}, 0, 1, 0, ptime.ClampToMonthEnd, pdate{1403, ptime.Farvardin, 29}},
+		{pdate{1402, ptime.Esfand, 29}, 0, 1, 0, ptime.PreserveMonthEnd, pdate{1403, ptime.Farvardin, 31}},
+		{pdate{1403, ptime.Bahman, 30}, 0, 1, 0, ptime.PreserveMonthEnd, pdate{1403, ptime.Esfand, 30}},
+		{pdate{1403, ptime.Esfand, 30}, 1, 0, 0, ptime.Overflow, pdate{1405, ptime.Farvardin, 1}},
+		{pdate{1403, ptime.Esfand, 30}, 1, 0, 0, ptime.ClampToMonthEnd, pdate{1404, ptime.Esfand, 29}},
+		{pdate{1403, ptime.Farvardin, 31}, 0, 11, 1, ptime.ClampToMonthEnd, pdate{1404, ptime.Farvardin, 1}},
+		{pdate{1403, ptime.Farvardin, 15}, 0, 6, 0, ptime.PreserveMonthEnd, pdate{1403, ptime.Mehr, 15}},
+	}
+
+	for _, tt := range tests {
+		ti := ptime.Date(tt.from.year, tt.from.month, tt.from.day, 10, 0, 0, 0, ptime.Iran())
+		res := ti.AddDateWith(tt.years, tt.months, tt.days, tt.policy)
+
+		if res.Year() != tt.expected.year || res.Month() != tt.expected.month || res.Day() != tt.expected.day || res.Hour() != 10 {
+			t.Error(
+				"For", ti, tt.years, tt.months, tt.days, tt.policy,
+				"expected", tt.expected,
+				"got", res,
+			)
+		}
+	}
+
+	// A subscription anchored on the 31st does not drift with PreserveMonthEnd.
+	ti := ptime.Date(1403, ptime.Farvardin, 31, 0, 0, 0, 0, ptime.Iran())
+	for i := 1; i <= 12; i++ {
+		ti = ti.AddMonths(1, ptime.PreserveMonthEnd)
+	}
+
+	if ti.Year() != 1404 || ti.Month() != ptime.Farvardin || ti.Day() != 31 {
+		t.Error(
+			"For", "AddMonths(1, PreserveMonthEnd) x 12",
+			"expected", "1404/01/31",
+			"got", ti,
+		)
+	}
+}
+
 func TestWeeks(t *testing.T) {
 	ti := ptime.Date(1394, ptime.Mehr, 2, 12, 59, 59, 0, ptime.Iran())
 
@@ -517,10 +618,10 @@ func TestWeeks(t *testing.T) {
 		)
 	}
 
-	if ti.RYearWeek() != 25 {
+	if ti.RYearWeek() != 26 {
 		t.Error(
 			"For", "RYearWeek()",
-			"expected", 25,
+			"expected", 26,
 			"got", ti.RYearWeek(),
 		)
 	}
@@ -542,6 +643,43 @@ func TestWeeks(t *testing.T) {
 	}
 }
 
+func TestSub(t *testing.T) {
+	t1 := ptime.Date(1394, ptime.Esfand, 29, 23, 0, 0, 0, ptime.Iran())
+	t2 := ptime.Date(1395, ptime.Farvardin, 1, 1, 30, 0, 500, ptime.Iran())
+
+	if d := t2.Sub(t1); d != 2*time.Hour+30*time.Minute+500 {
+		t.Error(
+			"For", "Sub",
+			"expected", 2*time.Hour+30*time.Minute+500,
+			"got", d,
+		)
+	}
+
+	if d := t1.Sub(t2); d != -(2*time.Hour + 30*time.Minute + 500) {
+		t.Error(
+			"For", "Sub",
+			"expected", -(2*time.Hour + 30*time.Minute + 500),
+			"got", d,
+		)
+	}
+
+	if d := ptime.Since(ptime.Now().Add(-time.Hour)); d < time.Hour || d > time.Hour+time.Minute {
+		t.Error(
+			"For", "Since",
+			"expected", time.Hour,
+			"got", d,
+		)
+	}
+
+	if d := ptime.Until(ptime.Now().Add(time.Hour)); d > time.Hour || d < time.Hour-time.Minute {
+		t.Error(
+			"For", "Until",
+			"expected", time.Hour,
+			"got", d,
+		)
+	}
+}
+
 func TestPanic(t *testing.T) {
 	defer func() {
 		if r := recover(); r != nil {
@@ -681,12 +819,12 @@ func TestTimeFormat(t *testing.T) {
 		"4":          "7",
 		"05":         "08",
 		"5":          "8",
-		".000":       ".520",
-		".000000":    ".520650",
-		".000000000": ".52065090",
-		".999":       ".52",
-		".999999":    ".52065",
-		".999999999": ".5206509",
+		".000":       ".052",
+		".000000":    ".052065",
+		".000000000": ".052065090",
+		".999":       ".052",
+		".999999":    ".052065",
+		".999999999": ".05206509",
 		"PM":         "بعد از ظهر",
 		"pm":         "ب.ظ",
 		"MST":        "Asia/Tehran",
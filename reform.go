@@ -0,0 +1,61 @@
+package ptime
+
+import "math"
+
+// A Reform is the Julian Day Number of the first day of Gregorian calendar in the civil calendar of a country,
+// which used Julian calendar before it. A Reform converts the dates of that civil calendar, e.g. the dates of
+// historical documents, without a time.Location. The dates between the last Julian day and the first Gregorian
+// day did not exist, and they are converted as Julian dates, i.e. they overflow into Gregorian calendar.
+type Reform int
+
+// List of the reforms of some countries.
+const (
+	GregorianReform    Reform = gregorianReformJulianDay + 1 // October 15, 1582, the reform of the Catholic countries
+	BritishReform      Reform = 2361222                      // September 14, 1752, the reform of Britain and its colonies
+	OttomanReform      Reform = 2421289                      // March 1, 1917, the reform of the Ottoman Empire
+	RussianReform      Reform = 2421639                      // February 14, 1918, the reform of Russia
+	ProlepticGregorian Reform = math.MinInt                  // Gregorian calendar for all dates
+	ProlepticJulian    Reform = math.MaxInt                  // Julian calendar for all dates
+)
+
+// NewReform returns the Reform whose first day of Gregorian calendar is year, month and day in Gregorian calendar.
+func NewReform(year, month, day int) Reform {
+	return Reform(GregorianToJDN(year, month, day))
+}
+
+// IsGregorian reports whether the day of the Julian Day Number is in Gregorian calendar.
+func (r Reform) IsGregorian(jdn int) bool {
+	return jdn >= int(r)
+}
+
+// ToJDN returns the Julian Day Number of a date in the civil calendar of r.
+// The month and day values may be outside their usual ranges and are normalized.
+//
+// Note that the year numbers are counted from January 1, e.g. the dates of England before 1752
+// whose year started on March 25 need to be converted first.
+func (r Reform) ToJDN(year, month, day int) int {
+	year, month = normMonth(year, month)
+
+	if jdn := convertGregorianPostReformToJDN(year, month, day); r.IsGregorian(jdn) {
+		return jdn
+	}
+	return convertGregorianPreReformToJDN(year, month, day)
+}
+
+// FromJDN returns the date of the Julian Day Number in the civil calendar of r.
+func (r Reform) FromJDN(jdn int) (year, month, day int) {
+	if r.IsGregorian(jdn) {
+		return convertJDNToGregorianPostReform(jdn)
+	}
+	return convertJDNToGregorianPreReform(jdn)
+}
+
+// ToPersian converts a date in the civil calendar of r to Persian calendar by ArithmeticCalendar.
+func (r Reform) ToPersian(year, month, day int) (int, int, int) {
+	return JDNToPersian(r.ToJDN(year, month, day))
+}
+
+// FromPersian converts a date in Persian calendar by ArithmeticCalendar to the civil calendar of r.
+func (r Reform) FromPersian(year, month, day int) (int, int, int) {
+	return r.FromJDN(PersianToJDN(year, month, day))
+}
@@ -0,0 +1,69 @@
+package ptime_test
+
+import (
+	"testing"
+
+	ptime "github.com/yaa110/go-persian-calendar"
+)
+
+func TestReform(t *testing.T) {
+	tests := []struct {
+		reform     ptime.Reform
+		lastJulian [3]int
+		first      [3]int
+	}{
+		{ptime.GregorianReform, [3]int{1582, 10, 4}, [3]int{1582, 10, 15}},
+		{ptime.BritishReform, [3]int{1752, 9, 2}, [3]int{1752, 9, 14}},
+		{ptime.OttomanReform, [3]int{1917, 2, 15}, [3]int{1917, 3, 1}},
+		{ptime.RussianReform, [3]int{1918, 1, 31}, [3]int{1918, 2, 14}},
+	}
+
+	for _, tt := range tests {
+		if r := ptime.NewReform(tt.first[0], tt.first[1], tt.first[2]); r != tt.reform {
+			t.Error("For", tt.first, "expected", tt.reform, "got", r)
+		}
+
+		if y, m, d := tt.reform.FromJDN(int(tt.reform) - 1); [3]int{y, m, d} != tt.lastJulian {
+			t.Error("For", tt.reform, "expected", tt.lastJulian, "got", y, m, d)
+		}
+
+		if y, m, d := tt.reform.FromJDN(int(tt.reform)); [3]int{y, m, d} != tt.first {
+			t.Error("For", tt.reform, "expected", tt.first, "got", y, m, d)
+		}
+
+		if jdn := tt.reform.ToJDN(tt.lastJulian[0], tt.lastJulian[1], tt.lastJulian[2]); jdn != int(tt.reform)-1 {
+			t.Error("For", tt.lastJulian, "expected", int(tt.reform)-1, "got", jdn)
+		}
+	}
+
+	// The days which did not exist overflow into Gregorian calendar.
+	if a, b := ptime.BritishReform.ToJDN(1752, 9, 5), ptime.BritishReform.ToJDN(1752, 9, 16); a != b {
+		t.Error("Expected", b, "got", a)
+	}
+
+	if a, b := ptime.ProlepticJulian.ToJDN(2024, 3, 7), ptime.ProlepticGregorian.ToJDN(2024, 3, 20); a != b {
+		t.Error("Expected", b, "got", a)
+	}
+}
+
+func TestReformPersian(t *testing.T) {
+	tests := []struct {
+		reform ptime.Reform
+		civil  [3]int
+	}{
+		{ptime.GregorianReform, [3]int{1721, 3, 21}},
+		{ptime.BritishReform, [3]int{1721, 3, 10}},
+		{ptime.RussianReform, [3]int{1721, 3, 10}},
+		{ptime.ProlepticGregorian, [3]int{1721, 3, 21}},
+	}
+
+	for _, tt := range tests {
+		if y, m, d := tt.reform.FromPersian(1100, 1, 1); [3]int{y, m, d} != tt.civil {
+			t.Error("For", tt.reform, "expected", tt.civil, "got", y, m, d)
+		}
+
+		if y, m, d := tt.reform.ToPersian(tt.civil[0], tt.civil[1], tt.civil[2]); [3]int{y, m, d} != [3]int{1100, 1, 1} {
+			t.Error("For", tt.reform, "expected", [3]int{1100, 1, 1}, "got", y, m, d)
+		}
+	}
+}
@@ -0,0 +1,80 @@
+package ptime
+
+// A Season specifies a season (quarter) of the year starting from Bahar = 1.
+type Season int
+
+// List of seasons.
+const (
+	Bahar    Season = 1 + iota // Farvardin to Khordad
+	Tabestan                   // Tir to Shahrivar
+	Paeez                      // Mehr to Azar
+	Zemestan                   // Dey to Esfand
+)
+
+var seasons = [4]string{
+	"بهار",
+	"تابستان",
+	"پاییز",
+	"زمستان",
+}
+
+var dseasons = [4]string{
+	"بهار",
+	"تابستان",
+	"خزان",
+	"زمستان",
+}
+
+// String returns the Persian name of the season.
+func (s Season) String() string {
+	return seasons[s.index()]
+}
+
+// Dari returns the Dari name of the season.
+func (s Season) Dari() string {
+	return dseasons[s.index()]
+}
+
+// FirstMonth returns the first month of the season.
+func (s Season) FirstMonth() Month {
+	return Month(s.index()*3) + 1
+}
+
+// index returns the index of s in the list of names.
+func (s Season) index() int {
+	switch {
+	case s < Bahar:
+		return 0
+	case s > Zemestan:
+		return 3
+	default:
+		return int(s - 1)
+	}
+}
+
+// Season returns the season of the month.
+func (m Month) Season() Season {
+	return Season((m-1)/3) + 1
+}
+
+// Quarter returns the quarter of year of t in the range [1, 4].
+func (t Time) Quarter() int {
+	return int(t.Season())
+}
+
+// Season returns the season of t, which is the same as its quarter of year.
+func (t Time) Season() Season {
+	return t.month.Season()
+}
+
+// AddQuarters returns a new instance of Time for t.month+3*quarters, policy specifies how
+// a day which does not exist in the resulting month is handled. See AddDateWith.
+func (t Time) AddQuarters(quarters int, policy MonthEndPolicy) Time {
+	return t.AddDateWith(0, 3*quarters, 0, policy)
+}
+
+// QuartersSince returns the number of quarters of year from the quarter of u to the quarter of t,
+// which is negative if t is before u.
+func (t Time) QuartersSince(u Time) int {
+	return (t.year-u.year)*4 + t.Quarter() - u.Quarter()
+}
@@ -0,0 +1,132 @@
+package ptime_test
+
+import (
+	"strings"
+	"testing"
+
+	ptime "github.com/yaa110/go-persian-calendar"
+)
+
+func TestSeason(t *testing.T) {
+	vals := []struct {
+		month   ptime.Month
+		season  ptime.Season
+		name    string
+		dari    string
+		quarter int
+	}{
+		{ptime.Farvardin, ptime.Bahar, "بهار", "بهار", 1},
+		{ptime.Khordad, ptime.Bahar, "بهار", "بهار", 1},
+		{ptime.Tir, ptime.Tabestan, "تابستان", "تابستان", 2},
+		{ptime.Mehr, ptime.Paeez, "پاییز", "خزان", 3},
+		{ptime.Azar, ptime.Paeez, "پاییز", "خزان", 3},
+		{ptime.Esfand, ptime.Zemestan, "زمستان", "زمستان", 4},
+	}
+
+	for _, v := range vals {
+		ti := ptime.Date(1403, v.month, 10, 12, 0, 0, 0, ptime.Iran())
+		if ti.Season() != v.season || ti.Quarter() != v.quarter || v.season.String() != v.name || v.season.Dari() != v.dari {
+			t.Error(
+				"For", v.month,
+				"expected", v.season, v.quarter, v.dari,
+				"got", ti.Season(), ti.Quarter(), ti.Season().Dari(),
+			)
+		}
+	}
+
+	ti := ptime.Date(1403, ptime.Azar, 30, 12, 0, 0, 0, ptime.Iran())
+
+	if s := ti.BeginningOfQuarter().String(); s != "1403-07-01T00:00:00.0+03:30" {
+		t.Error(
+			"Expected", "1403-07-01T00:00:00.0+03:30",
+			"got", s,
+		)
+	}
+
+	if s := ti.EndOfQuarter().String(); s != "1403-09-30T23:59:59.999999999+03:30" {
+		t.Error(
+			"Expected", "1403-09-30T23:59:59.999999999+03:30",
+			"got", s,
+		)
+	}
+
+	if s := ti.AddQuarters(1, ptime.ClampToMonthEnd).String(); s != "1403-12-30T12:00:00.0+03:30" {
+		t.Error(
+			"Expected", "1403-12-30T12:00:00.0+03:30",
+			"got", s,
+		)
+	}
+
+	if s := ti.AddQuarters(5, ptime.ClampToMonthEnd).String(); s != "1404-12-29T12:00:00.0+03:30" {
+		t.Error(
+			"Expected", "1404-12-29T12:00:00.0+03:30",
+			"got", s,
+		)
+	}
+
+	if n := ti.QuartersSince(ptime.Date(1402, ptime.Esfand, 29, 0, 0, 0, 0, ptime.Iran())); n != 3 {
+		t.Error(
+			"Expected", 3,
+			"got", n,
+		)
+	}
+}
+
+func TestSeasonFormat(t *testing.T) {
+	ti := ptime.Date(1403, ptime.Mehr, 10, 12, 0, 0, 0, ptime.Afghanistan())
+
+	vals := map[string]string{
+		"QQQ yyyy":      "پاییز 1403",
+		"QQI yyyy":      "خزان 1403",
+		"yyyy-Q":        "1403-3",
+		"Q/MMM/QQQ yyy": "3/مهر/پاییز 1403",
+	}
+
+	for layout, s := range vals {
+		if f := ti.Format(layout); f != s {
+			t.Error(
+				"For", layout,
+				"expected", s,
+				"got", f,
+			)
+		}
+	}
+
+	if s := ti.FormatDigits("QQQ yyyy", ptime.PersianDigits); s != "پاییز ۱۴۰۳" {
+		t.Error(
+			"Expected", "پاییز ۱۴۰۳",
+			"got", s,
+		)
+	}
+
+	parsed := map[string]string{
+		"QQQ yyyy|بهار ۱۴۰۳":       "1403-01-01T00:00:00.0+03:30",
+		"QQI yyyy|خزان 1403":       "1403-07-01T00:00:00.0+03:30",
+		"yyyy-Q|1403-4":            "1403-10-01T00:00:00.0+03:30",
+		"QQQ yyyy/MM|بهار 1403/03": "1403-03-01T00:00:00.0+03:30",
+	}
+
+	for v, s := range parsed {
+		layout, value, _ := strings.Cut(v, "|")
+		pt, err := ptime.Parse(layout, value, ptime.Iran())
+		if err != nil || pt.String() != s {
+			t.Error(
+				"For", value,
+				"expected", s,
+				"got", pt, err,
+			)
+		}
+	}
+
+	invalid := [][2]string{
+		{"yyyy-Q", "1403-5"},
+		{"QQQ yyyy", "خزان 1403"},
+		{"QQQ yyyy/MM", "بهار 1403/04"},
+	}
+
+	for _, v := range invalid {
+		if _, err := ptime.Parse(v[0], v[1], ptime.Iran()); err == nil {
+			t.Error("Expected an error for", v[1])
+		}
+	}
+}
@@ -0,0 +1,121 @@
+package ptime
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Scan implements the sql.Scanner interface.
+//
+// The value may be a time.Time, or a string or []byte which is either a Gregorian time
+// in RFC 3339 format or a Persian time in the format of String (or MarshalText).
+// Since both formats look alike, they are told apart by the shape of MarshalText: its fractional
+// second is always present, it is the nanoseconds without leading zeros (e.g. .0 or .52065090),
+// and its zone is never Z. An error is returned if the string may be in either format,
+// i.e. it has a zone offset and a fractional second like .5 which neither begins nor ends with 0.
+func (t *Time) Scan(value any) error {
+	switch v := value.(type) {
+	case time.Time:
+		if v.IsZero() {
+			*t = Time{}
+		} else {
+			*t = New(v)
+		}
+		return nil
+	case string:
+		return t.scanString(v)
+	case []byte:
+		return t.scanString(string(v))
+	case nil:
+		return errors.New("ptime: cannot scan NULL into Time, use NullTime instead")
+	}
+
+	return fmt.Errorf("ptime: cannot scan %T into Time", value)
+}
+
+func (t *Time) scanString(s string) error {
+	persian, ok := isTextFormat(s)
+	if !ok {
+		return fmt.Errorf("ptime: cannot scan %q into Time, it may be either a Gregorian or a Persian time", s)
+	}
+
+	if persian {
+		return t.UnmarshalText([]byte(s))
+	}
+
+	gt, err := time.Parse(time.RFC3339Nano, s)
+	if err != nil {
+		return err
+	}
+
+	*t = New(gt)
+	return nil
+}
+
+// isTextFormat reports whether s is in the format of MarshalText rather than RFC 3339,
+// ok is false if s may be in either format. See Scan.
+func isTextFormat(s string) (persian, ok bool) {
+	switch {
+	case s == "" || strings.HasSuffix(s, "]"):
+		return true, true
+	case strings.HasSuffix(s, "Z"):
+		return false, true
+	}
+
+	i := strings.IndexByte(s, '.')
+	if i < 0 {
+		return false, true
+	}
+
+	j := i + 1
+	for j < len(s) && isDigit(s[j]) {
+		j++
+	}
+
+	switch frac := s[i+1 : j]; {
+	case frac == "" || frac[0] == '0' && frac != "0":
+		return false, true
+	case frac[len(frac)-1] == '0':
+		return true, true
+	default:
+		return false, false
+	}
+}
+
+// Value implements the driver.Valuer interface.
+// The time is written as the Gregorian instant returned by Time, and the zero Time as the zero time.Time.
+func (t Time) Value() (driver.Value, error) {
+	if t.IsZero() {
+		return time.Time{}, nil
+	}
+	return t.Time(), nil
+}
+
+// NullTime represents a Time that may be null.
+// NullTime implements the sql.Scanner interface so it can be used as a scan destination, similar to sql.NullTime.
+type NullTime struct {
+	Time  Time
+	Valid bool // Valid is true if Time is not NULL
+}
+
+// Scan implements the sql.Scanner interface.
+func (n *NullTime) Scan(value any) error {
+	if value == nil {
+		n.Time, n.Valid = Time{}, false
+		return nil
+	}
+
+	n.Valid = true
+	return n.Time.Scan(value)
+}
+
+// Value implements the driver.Valuer interface.
+func (n NullTime) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil //nolint:nilnil
+	}
+	return n.Time.Value()
+}
@@ -0,0 +1,145 @@
+package ptime_test
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"testing"
+	"time"
+
+	ptime "github.com/yaa110/go-persian-calendar"
+)
+
+var (
+	_ sql.Scanner   = (*ptime.Time)(nil)
+	_ driver.Valuer = ptime.Time{}
+	_ sql.Scanner   = (*ptime.NullTime)(nil)
+	_ driver.Valuer = ptime.NullTime{}
+)
+
+func TestScan(t *testing.T) {
+	ti := ptime.Date(1394, ptime.Mehr, 2, 14, 7, 8, 52065090, ptime.Iran())
+
+	values := []any{
+		ti.Time(),
+		"2015-09-24T14:07:08.05206509+03:30",
+		[]byte("2015-09-24T10:37:08.05206509Z"),
+		ti.String(),
+		"1394-07-02T14:07:08.52065090+03:30[Asia/Tehran]",
+	}
+
+	for _, v := range values {
+		var pt ptime.Time
+		if err := pt.Scan(v); err != nil || !pt.Equal(ti) {
+			t.Error(
+				"For", v,
+				"expected", ti,
+				"got", pt, err,
+			)
+		}
+	}
+
+	var pt ptime.Time
+	if err := pt.Scan(nil); err == nil {
+		t.Error("Expected an error for NULL")
+	}
+
+	if err := pt.Scan(int64(1443091028)); err == nil {
+		t.Error("Expected an error for int64")
+	}
+
+	if err := pt.Scan("not a time"); err == nil {
+		t.Error("Expected an error for an invalid string")
+	}
+}
+
+func TestScanFormat(t *testing.T) {
+	vals := map[string]string{
+		// Gregorian times before 1700 and Persian times after 1700.
+		"1650-03-20T12:00:00Z":                     "1028-12-30T12:00:00.0+00:00",
+		"1650-03-20T15:30:00+03:30":                "1028-12-30T15:30:00.0+03:30",
+		"1650-03-20T12:00:00.05Z":                  "1028-12-30T12:00:00.50000000+00:00",
+		"1650-03-20T15:30:00.05+03:30":             "1028-12-30T15:30:00.50000000+03:30",
+		"1750-01-01T00:00:00.0+03:30":              "1750-01-01T00:00:00.0+03:30",
+		"1750-01-01T00:00:00.500+03:30":            "1750-01-01T00:00:00.500+03:30",
+		"1750-01-01T00:00:00.5+03:30[Asia/Tehran]": "1750-01-01T00:00:00.5+03:30",
+		"2024-03-20T03:06:21.5Z":                   "1403-01-01T03:06:21.500000000+00:00",
+	}
+
+	for v, s := range vals {
+		var pt ptime.Time
+		if err := pt.Scan(v); err != nil || pt.String() != s {
+			t.Error(
+				"For", v,
+				"expected", s,
+				"got", pt, err,
+			)
+		}
+	}
+
+	// A fractional second which neither begins nor ends with 0 is in both formats.
+	var pt ptime.Time
+	if err := pt.Scan("1394-07-02T14:07:08.5+03:30"); err == nil {
+		t.Error("Expected an error for an ambiguous string, got", pt)
+	}
+}
+
+func TestValue(t *testing.T) {
+	ti := ptime.Date(1394, ptime.Mehr, 2, 14, 7, 8, 0, ptime.Iran())
+
+	v, err := ti.Value()
+	if gt, ok := v.(time.Time); err != nil || !ok || !gt.Equal(ti.Time()) {
+		t.Error(
+			"Expected", ti.Time(),
+			"got", v, err,
+		)
+	}
+
+	v, _ = ptime.Time{}.Value()
+	if gt, ok := v.(time.Time); !ok || !gt.IsZero() {
+		t.Error(
+			"Expected", time.Time{},
+			"got", v,
+		)
+	}
+
+	var pt ptime.Time
+	if err := pt.Scan(v); err != nil || !pt.IsZero() {
+		t.Error(
+			"Expected", "zero time",
+			"got", pt, err,
+		)
+	}
+}
+
+func TestNullTime(t *testing.T) {
+	ti := ptime.Date(1394, ptime.Mehr, 2, 14, 7, 8, 0, ptime.Iran())
+
+	var nt ptime.NullTime
+	if err := nt.Scan(ti.Time()); err != nil || !nt.Valid || !nt.Time.Equal(ti) {
+		t.Error(
+			"Expected", ti,
+			"got", nt.Time, nt.Valid, err,
+		)
+	}
+
+	if v, err := nt.Value(); err != nil || !v.(time.Time).Equal(ti.Time()) {
+		t.Error(
+			"Expected", ti.Time(),
+			"got", v, err,
+		)
+	}
+
+	if err := nt.Scan(nil); err != nil || nt.Valid || !nt.Time.IsZero() {
+		t.Error(
+			"Expected", "invalid NullTime",
+			"got", nt.Time, nt.Valid, err,
+		)
+	}
+
+	if v, err := nt.Value(); err != nil || v != nil {
+		t.Error(
+			"Expected", nil,
+			"got", v, err,
+		)
+	}
+}
@@ -0,0 +1,122 @@
+package ptime
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// List of errors returned by the strict counterparts of Date, Set and In.
+var (
+	ErrInvalidMonth = errors.New("ptime: invalid month")
+	ErrInvalidDay   = errors.New("ptime: invalid day")
+	ErrInvalidClock = errors.New("ptime: invalid clock")
+	ErrNilLocation  = errors.New("ptime: nil location")
+	ErrYearRange    = errors.New("ptime: year out of range")
+	ErrZeroTime     = errors.New("ptime: zero time")
+)
+
+// The range of the years of Persian calendar which are supported, including the negative years before the epoch.
+// Year 0 is the year before 1 and the Gregorian dates are proleptic.
+const (
+	MinYear = -1000000
+	MaxYear = 1000000
+)
+
+// ValidateDate returns an error if year, month and day do not represent a day in Persian calendar.
+// The error wraps ErrYearRange if year is not in the range [MinYear, MaxYear], ErrInvalidMonth if month is not in the range [1, 12], or ErrInvalidDay
+// if day is not in the range of the days of month (e.g. 30 Esfand of a non-leap year). The leap years are determined by ArithmeticCalendar.
+func ValidateDate(year int, month Month, day int) error {
+	return validateDateIn(defaultCalendar, year, month, day)
+}
+
+// validateDateIn is like ValidateDate, using cal to determine the leap years.
+func validateDateIn(cal Calendar, year int, month Month, day int) error {
+	if year < MinYear || year > MaxYear {
+		return fmt.Errorf("%w %d, the range is [%d, %d]", ErrYearRange, year, MinYear, MaxYear)
+	}
+
+	if month < Farvardin || month > Esfand {
+		return fmt.Errorf("%w %d", ErrInvalidMonth, month)
+	}
+
+	if n := monthDaysIn(cal, year, month); day < 1 || day > n {
+		return fmt.Errorf("%w %d, %s of %d has %d days", ErrInvalidDay, day, month, year, n)
+	}
+
+	return nil
+}
+
+// ValidateClock returns an error which wraps ErrInvalidClock if hour, minute, sec seconds and
+// nsec nanoseconds are not in the range [0, 23], [0, 59], [0, 59] and [0, 999999999] respectively.
+func ValidateClock(hour, minute, sec, nsec int) error {
+	if hour < 0 || hour > 23 || minute < 0 || minute > 59 || sec < 0 || sec > 59 || nsec < 0 || nsec > 999999999 {
+		return fmt.Errorf("%w %02d:%02d:%02d.%09d", ErrInvalidClock, hour, minute, sec, nsec)
+	}
+
+	return nil
+}
+
+// DateStrict returns a new instance of Time like Date, but it returns an error instead of
+// normalizing out of range values. See ValidateDate and ValidateClock for the errors.
+// If loc is nil, an error which wraps ErrNilLocation is returned.
+func DateStrict(year int, month Month, day, hour, minute, sec, nsec int, loc *time.Location) (Time, error) {
+	var t Time
+	if err := t.SetStrict(year, month, day, hour, minute, sec, nsec, loc); err != nil {
+		return Time{}, err
+	}
+
+	return t, nil
+}
+
+// NewStrict converts Gregorian calendar to Persian calendar like New, but it returns an error
+// which wraps ErrZeroTime if t is the zero time.Time, or ErrYearRange if the Persian year is not
+// in the range [MinYear, MaxYear], instead of a zero instance of Time.
+func NewStrict(t time.Time) (Time, error) {
+	return NewStrictWith(t, nil)
+}
+
+// NewStrictWith is like NewStrict, using cal to determine the leap years. If cal is nil then ArithmeticCalendar is used.
+func NewStrictWith(t time.Time, cal Calendar) (Time, error) {
+	if t.IsZero() {
+		return Time{}, fmt.Errorf("%w in call to NewStrict", ErrZeroTime)
+	}
+
+	pt := Time{cal: cal}
+	pt.SetTime(t)
+
+	if pt.year < MinYear || pt.year > MaxYear {
+		return Time{}, fmt.Errorf("%w, %s is not in the range [%d, %d]", ErrYearRange, t.Format(time.DateOnly), MinYear, MaxYear)
+	}
+
+	return pt, nil
+}
+
+// SetStrict sets t like Set, but it returns an error and leaves t unchanged instead of
+// normalizing out of range values or panicking if loc is nil. The date is validated in the calendar of t.
+func (t *Time) SetStrict(year int, month Month, day, hour, minute, sec, nsec int, loc *time.Location) error {
+	if loc == nil {
+		return fmt.Errorf("%w in call to SetStrict", ErrNilLocation)
+	}
+
+	if err := validateDateIn(t.calendar(), year, month, day); err != nil {
+		return err
+	}
+
+	if err := ValidateClock(hour, minute, sec, nsec); err != nil {
+		return err
+	}
+
+	t.Set(year, month, day, hour, minute, sec, nsec, loc)
+	return nil
+}
+
+// InStrict sets the location of t like In, but it returns an error which wraps ErrNilLocation
+// instead of panicking if loc is nil.
+func (t Time) InStrict(loc *time.Location) (Time, error) {
+	if loc == nil {
+		return Time{}, fmt.Errorf("%w in call to InStrict", ErrNilLocation)
+	}
+
+	return t.In(loc), nil
+}
@@ -0,0 +1,225 @@
+package ptime_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	ptime "github.com/yaa110/go-persian-calendar"
+)
+
+func TestValidateDate(t *testing.T) {
+	tests := []struct {
+		year  int
+		month ptime.Month
+		day   int
+		err   error
+	}{
+		{1394, ptime.Mehr, 30, nil},
+		{1394, ptime.Mehr, 31, ptime.ErrInvalidDay},
+		{1394, ptime.Shahrivar, 31, nil},
+		{1394, ptime.Esfand, 30, ptime.ErrInvalidDay},
+		{1395, ptime.Esfand, 30, nil},
+		{1395, ptime.Farvardin, 0, ptime.ErrInvalidDay},
+		{1395, 0, 1, ptime.ErrInvalidMonth},
+		{1395, 13, 1, ptime.ErrInvalidMonth},
+		{-1395, ptime.Esfand, 29, nil},
+		{ptime.MaxYear + 1, ptime.Farvardin, 1, ptime.ErrYearRange},
+	}
+
+	for _, tt := range tests {
+		if err := ptime.ValidateDate(tt.year, tt.month, tt.day); !errors.Is(err, tt.err) || (tt.err == nil && err != nil) {
+			t.Error(
+				"For", tt.year, int(tt.month), tt.day,
+				"expected", tt.err,
+				"got", err,
+			)
+		}
+	}
+}
+
+func TestDateStrict(t *testing.T) {
+	ti := ptime.Date(1394, ptime.Mehr, 2, 12, 59, 59, 0, ptime.Iran())
+
+	pt, err := ptime.DateStrict(1394, ptime.Mehr, 2, 12, 59, 59, 0, ptime.Iran())
+	if err != nil || pt.String() != ti.String() || pt.Weekday() != ti.Weekday() {
+		t.Error(
+			"Expected", ti,
+			"got", pt, err,
+		)
+	}
+
+	if _, err := ptime.DateStrict(1394, ptime.Mehr, 31, 0, 0, 0, 0, ptime.Iran()); !errors.Is(err, ptime.ErrInvalidDay) {
+		t.Error("Expected", ptime.ErrInvalidDay, "got", err)
+	}
+
+	if _, err := ptime.DateStrict(1394, ptime.Mehr, 1, 24, 0, 0, 0, ptime.Iran()); !errors.Is(err, ptime.ErrInvalidClock) {
+		t.Error("Expected", ptime.ErrInvalidClock, "got", err)
+	}
+
+	if _, err := ptime.DateStrict(1394, ptime.Mehr, 1, 0, 0, 0, 0, nil); !errors.Is(err, ptime.ErrNilLocation) {
+		t.Error("Expected", ptime.ErrNilLocation, "got", err)
+	}
+
+	pt = ti
+	if err := pt.SetStrict(1394, 13, 1, 0, 0, 0, 0, ptime.Iran()); !errors.Is(err, ptime.ErrInvalidMonth) || pt.Month() != ptime.Mehr {
+		t.Error("Expected", ptime.ErrInvalidMonth, "got", err, pt)
+	}
+
+	if _, err := pt.InStrict(nil); !errors.Is(err, ptime.ErrNilLocation) {
+		t.Error("Expected", ptime.ErrNilLocation, "got", err)
+	}
+
+	if in, err := pt.InStrict(ptime.Afghanistan()); err != nil || in.Location().String() != "Asia/Kabul" {
+		t.Error("Expected", "Asia/Kabul", "got", in.Location(), err)
+	}
+}
+
+func TestSetStrictCalendar(t *testing.T) {
+	// 1403 is a leap year in ArithmeticCalendar and 1404 in BirashkCalendar.
+	pt := ptime.DateWith(1403, ptime.Mehr, 1, 0, 0, 0, 0, time.UTC, ptime.BirashkCalendar{})
+
+	if err := pt.SetStrict(1403, ptime.Esfand, 30, 0, 0, 0, 0, time.UTC); !errors.Is(err, ptime.ErrInvalidDay) || pt.Month() != ptime.Mehr {
+		t.Error("Expected", ptime.ErrInvalidDay, "got", err, pt)
+	}
+
+	if err := pt.SetStrict(1404, ptime.Esfand, 30, 0, 0, 0, 0, time.UTC); err != nil || pt.Year() != 1404 || pt.Day() != 30 {
+		t.Error(
+			"Expected", "1404-12-30",
+			"got", pt, err,
+		)
+	}
+}
+
+func TestNewStrict(t *testing.T) {
+	gt := time.Date(1000, time.March, 1, 12, 0, 0, 0, time.UTC)
+
+	pt, err := ptime.NewStrict(gt)
+	if err != nil || pt.Format("yyyy-MM-dd") != "0378-12-10" || !pt.Time().Equal(gt) {
+		t.Error(
+			"Expected", "0378-12-10",
+			"got", pt.Format("yyyy-MM-dd"), err,
+		)
+	}
+
+	if _, err := ptime.NewStrict(time.Time{}); !errors.Is(err, ptime.ErrZeroTime) {
+		t.Error("Expected", ptime.ErrZeroTime, "got", err)
+	}
+
+	gt = time.Date(ptime.MaxYear+622, time.March, 30, 0, 0, 0, 0, time.UTC)
+	if _, err := ptime.NewStrict(gt); !errors.Is(err, ptime.ErrYearRange) {
+		t.Error("Expected", ptime.ErrYearRange, "got", err)
+	}
+
+	if pt := ptime.New(gt); !pt.IsZero() {
+		t.Error("Expected", ptime.Time{}, "got", pt)
+	}
+}
+
+func TestProlepticRange(t *testing.T) {
+	years := []int{ptime.MinYear, -100000, -1595, -622, -1, 0, 1, 621, 1000, ptime.MaxYear}
+
+	for _, year := range years {
+		pt := ptime.Date(year, ptime.Mehr, 5, 10, 30, 0, 0, time.UTC)
+		if u := ptime.New(pt.Time()); u != pt {
+			t.Error(
+				"For", year,
+				"expected", pt,
+				"got", u,
+			)
+		}
+
+		if u, err := ptime.Parse("yyyy-MM-dd HH:mm", pt.Format("yyyy-MM-dd HH:mm"), time.UTC); err != nil || u != pt {
+			t.Error(
+				"For", year,
+				"expected", pt,
+				"got", u, err,
+			)
+		}
+	}
+
+	pt := ptime.Date(-100, ptime.Mehr, 5, 0, 0, 0, 0, time.UTC)
+	if s := pt.Format("yyyy-MM-dd yy"); s != "-0100-07-05 00" {
+		t.Error("Expected", "-0100-07-05 00", "got", s)
+	}
+
+	if s := pt.TimeFormat("2006-01-02"); s != "-0100-07-05" {
+		t.Error("Expected", "-0100-07-05", "got", s)
+	}
+
+	// 1 Farvardin 1 of the 33-year arithmetic cycle, time.Time is in proleptic Gregorian calendar.
+	if gt := ptime.Date(1, ptime.Farvardin, 1, 0, 0, 0, 0, time.UTC).Time(); !gt.Equal(time.Date(622, time.March, 21, 0, 0, 0, 0, time.UTC)) {
+		t.Error("Expected", "622-03-21", "got", gt)
+	}
+}
+
+func TestDateRange(t *testing.T) {
+	for _, year := range []int{ptime.MinYear, ptime.MaxYear} {
+		pt := ptime.Date(year, ptime.Mehr, 1, 0, 0, 0, 0, time.UTC)
+		if pt.IsZero() || ptime.New(pt.Time()) != pt {
+			t.Error(
+				"For", year,
+				"expected", pt,
+				"got", ptime.New(pt.Time()),
+			)
+		}
+	}
+
+	invalid := []ptime.Time{
+		ptime.Date(ptime.MaxYear+5, ptime.Farvardin, 1, 0, 0, 0, 0, time.UTC),
+		ptime.Date(ptime.MaxYear, ptime.Esfand, 31, 0, 0, 0, 0, time.UTC),
+		ptime.Date(ptime.MinYear, ptime.Farvardin, 0, 0, 0, 0, 0, time.UTC),
+		ptime.DateWith(ptime.MinYear-1, ptime.Farvardin, 1, 0, 0, 0, 0, time.UTC, ptime.BirashkCalendar{}),
+	}
+
+	for _, pt := range invalid {
+		if !pt.IsZero() {
+			t.Error("Expected the zero Time, got", pt)
+		}
+	}
+}
+
+func TestGregorianReformConsistency(t *testing.T) {
+	// The dates around the Gregorian reform of October 15, 1582 and long before it.
+	dates := []ptime.Time{
+		ptime.Date(900, ptime.Dey, 11, 12, 0, 0, 0, time.UTC),
+		ptime.Date(961, ptime.Mehr, 1, 0, 0, 0, 0, time.UTC),
+		ptime.Date(961, ptime.Aban, 1, 0, 0, 0, 0, time.UTC),
+		ptime.Date(-100, ptime.Farvardin, 1, 6, 0, 0, 0, time.UTC),
+	}
+
+	for _, pt := range dates {
+		if w := pt.CivilDate().Weekday(); pt.Weekday() != w || ptime.New(pt.Time()).Weekday() != w {
+			t.Error(
+				"For", pt,
+				"expected", w,
+				"got", pt.Weekday(), ptime.New(pt.Time()).Weekday(),
+			)
+		}
+
+		if u := ptime.New(pt.Time()); u != pt {
+			t.Error(
+				"For", pt,
+				"expected", pt,
+				"got", u,
+			)
+		}
+
+		y, m, d := pt.Time().Date()
+		if gy, gm, gd := ptime.JDNToGregorian(pt.JulianDayNumber()); gy != y || gm != int(m) || gd != d {
+			t.Error(
+				"For", pt,
+				"expected", gy, gm, gd,
+				"got", y, int(m), d,
+			)
+		}
+	}
+
+	// Mehr of 961 has 30 days, although the reform is in it.
+	if d := dates[2].Sub(dates[1]); d != 30*24*time.Hour || dates[2].JulianDayNumber()-dates[1].JulianDayNumber() != 30 {
+		t.Error(
+			"Expected", 30*24*time.Hour,
+			"got", d,
+		)
+	}
+}
@@ -0,0 +1,90 @@
+package ptime
+
+import "time"
+
+// A Unit specifies a Persian calendar unit for Truncate and Round.
+// The values out of the range [UnitDay, UnitYear] are clamped to it.
+type Unit int
+
+// List of calendar units.
+const (
+	UnitDay      Unit = iota // a day starting at midnight
+	UnitWeek                 // a week starting on Shanbeh
+	UnitMonth                // a month
+	UnitQuarter              // three months starting on Farvardin, Tir, Mehr and Dey
+	UnitHalfYear             // six months starting on Farvardin and Mehr
+	UnitYear                 // a year starting on Farvardin
+)
+
+// Truncate returns the beginning of the unit containing t, e.g. the first day of the month of t
+// at 00:00:00 for UnitMonth. If the beginning does not exist in the location of t because of
+// a daylight saving time transition, the first moment of the unit is returned instead.
+func (t Time) Truncate(unit Unit) Time {
+	start, _ := t.unitBounds(unit)
+	return start
+}
+
+// Round returns the beginning of the unit containing t or the beginning of the next unit,
+// whichever is nearer to t. The halfway values are rounded up. The distances are measured
+// in elapsed time, so the length of the units respects the daylight saving time transitions.
+func (t Time) Round(unit Unit) Time {
+	start, next := t.unitBounds(unit)
+	if t.Sub(start) < next.Sub(t) {
+		return start
+	}
+	return next
+}
+
+// TruncateDuration returns the result of rounding t down to a multiple of d like time.Time.Truncate.
+// The multiples are computed in absolute time since the zero time, not in the location of t.
+// If d <= 0, it returns t unchanged.
+func (t Time) TruncateDuration(d time.Duration) Time {
+	return t.newTime(t.Time().Truncate(d))
+}
+
+// RoundDuration returns the result of rounding t to the nearest multiple of d like time.Time.Round.
+// The halfway values are rounded up.
+func (t Time) RoundDuration(d time.Duration) Time {
+	return t.newTime(t.Time().Round(d))
+}
+
+// unitBounds returns the beginning of the unit containing t and the beginning of the next unit.
+func (t Time) unitBounds(unit Unit) (Time, Time) {
+	year, month, day := t.year, t.month, t.day
+	var years, months, days int
+
+	switch unit.clamp() {
+	case UnitDay:
+		days = 1
+	case UnitWeek:
+		day -= int(t.wday)
+		days = 7
+	case UnitMonth:
+		day, months = 1, 1
+	case UnitQuarter:
+		month, day, months = (month-1)/3*3+1, 1, 3
+	case UnitHalfYear:
+		month, day, months = (month-1)/6*6+1, 1, 6
+	case UnitYear:
+		month, day, years = Farvardin, 1, 1
+	}
+
+	loc := t.Time().Location()
+	start := t.date(year, month, day, 0, 0, 0, 0, loc)
+	next := t.date(year+years, month+Month(months), day+days, 0, 0, 0, 0, loc)
+
+	// Normalize the wall clock of the days which do not begin at midnight.
+	return t.newTime(start.Time()), t.newTime(next.Time())
+}
+
+// clamp returns u in the range [UnitDay, UnitYear].
+func (u Unit) clamp() Unit {
+	switch {
+	case u < UnitDay:
+		return UnitDay
+	case u > UnitYear:
+		return UnitYear
+	default:
+		return u
+	}
+}
@@ -0,0 +1,114 @@
+package ptime_test
+
+import (
+	"testing"
+	"time"
+
+	ptime "github.com/yaa110/go-persian-calendar"
+)
+
+func TestTruncate(t *testing.T) {
+	ti := ptime.Date(1403, ptime.Aban, 17, 15, 4, 5, 6, ptime.Iran()) // Panjshanbeh
+
+	tests := []struct {
+		unit     ptime.Unit
+		start    string
+		expected string
+	}{
+		{ptime.UnitDay, "1403-08-17T00:00:00.0+03:30", "1403-08-18T00:00:00.0+03:30"},
+		{ptime.UnitWeek, "1403-08-12T00:00:00.0+03:30", "1403-08-19T00:00:00.0+03:30"},
+		{ptime.UnitMonth, "1403-08-01T00:00:00.0+03:30", "1403-09-01T00:00:00.0+03:30"},
+		{ptime.UnitQuarter, "1403-07-01T00:00:00.0+03:30", "1403-10-01T00:00:00.0+03:30"},
+		{ptime.UnitHalfYear, "1403-07-01T00:00:00.0+03:30", "1403-07-01T00:00:00.0+03:30"},
+		{ptime.UnitYear, "1403-01-01T00:00:00.0+03:30", "1404-01-01T00:00:00.0+03:30"},
+	}
+
+	for _, tt := range tests {
+		if s := ti.Truncate(tt.unit).String(); s != tt.start {
+			t.Error(
+				"For", tt.unit,
+				"expected", tt.start,
+				"got", s,
+			)
+		}
+
+		if s := ti.Round(tt.unit).String(); s != tt.expected {
+			t.Error(
+				"For", tt.unit,
+				"expected", tt.expected,
+				"got", s,
+			)
+		}
+	}
+
+	// The week of 1 Farvardin 1404 (Jomeh) begins in the previous year.
+	nowruz := ptime.Date(1404, ptime.Farvardin, 1, 10, 0, 0, 0, ptime.Iran())
+	if s := nowruz.Truncate(ptime.UnitWeek).String(); s != "1403-12-25T00:00:00.0+03:30" {
+		t.Error(
+			"Expected", "1403-12-25T00:00:00.0+03:30",
+			"got", s,
+		)
+	}
+
+	// The invalid units are clamped.
+	if ti.Truncate(-1) != ti.Truncate(ptime.UnitDay) || ti.Round(ptime.UnitYear+1) != ti.Round(ptime.UnitYear) {
+		t.Error(
+			"Expected", ti.Truncate(ptime.UnitDay), ti.Round(ptime.UnitYear),
+			"got", ti.Truncate(-1), ti.Round(ptime.UnitYear+1),
+		)
+	}
+}
+
+func TestTruncateDST(t *testing.T) {
+	// The clock in Tehran jumped from 00:00 to 01:00 on 2 Farvardin 1400.
+	ti := ptime.Date(1400, ptime.Farvardin, 2, 12, 0, 0, 0, ptime.Iran())
+
+	if s := ti.Truncate(ptime.UnitDay).String(); s != "1400-01-02T01:00:00.0+04:30" {
+		t.Error(
+			"Expected", "1400-01-02T01:00:00.0+04:30",
+			"got", s,
+		)
+	}
+
+	// The day is 23 hours long, so its middle is 12:30.
+	if s := ti.Round(ptime.UnitDay).String(); s != "1400-01-02T01:00:00.0+04:30" {
+		t.Error(
+			"Expected", "1400-01-02T01:00:00.0+04:30",
+			"got", s,
+		)
+	}
+
+	ti.SetMinute(45)
+	if s := ti.Round(ptime.UnitDay).String(); s != "1400-01-03T00:00:00.0+04:30" {
+		t.Error(
+			"Expected", "1400-01-03T00:00:00.0+04:30",
+			"got", s,
+		)
+	}
+}
+
+func TestTruncateDuration(t *testing.T) {
+	ti := ptime.Date(1403, ptime.Aban, 17, 15, 4, 5, 6, ptime.Iran())
+
+	if s := ti.TruncateDuration(15 * time.Minute).String(); s != "1403-08-17T15:00:00.0+03:30" {
+		t.Error(
+			"Expected", "1403-08-17T15:00:00.0+03:30",
+			"got", s,
+		)
+	}
+
+	// The multiples of an hour are computed in absolute time, i.e. in UTC.
+	if s := ti.RoundDuration(time.Hour).String(); s != "1403-08-17T15:30:00.0+03:30" {
+		t.Error(
+			"Expected", "1403-08-17T15:30:00.0+03:30",
+			"got", s,
+		)
+	}
+
+	if s := ti.RoundDuration(time.Minute).String(); s != "1403-08-17T15:04:00.0+03:30" {
+		t.Error(
+			"Expected", "1403-08-17T15:04:00.0+03:30",
+			"got", s,
+		)
+	}
+}
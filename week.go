@@ -0,0 +1,185 @@
+package ptime
+
+import (
+	"fmt"
+	"time"
+)
+
+// weekDateLayout is the Layout of the ParseError returned by ParseWeekDate.
+const weekDateLayout = "yyyy-Www-d"
+
+// A WeekRule specifies how the weeks of a year are numbered.
+//
+// The weeks start on FirstDay and the first week of a year is the first week which has
+// at least MinDays days of the year. The days of a year before its first week belong to
+// the last week of the previous year, so a week-based year (week year) may begin before
+// or after 1 Farvardin. MinDays is in the range [1, 7], e.g. 4 numbers the weeks
+// like ISO 8601 does, where the first week has most of its days in the year.
+//
+// The default week rule of WeekYear, Week, WeeksInYear, FormatWeekDate, FromWeekDate and ParseWeekDate
+// is WeekRule{FirstDay: Shanbeh, MinDays: 1}, i.e. the first week of a year is the week of 1 Farvardin.
+// The methods of WeekRule number the weeks by the other rules.
+type WeekRule struct {
+	FirstDay Weekday
+	MinDays  int
+}
+
+// defaultWeekRule is the default week rule, see WeekRule.
+var defaultWeekRule = WeekRule{FirstDay: Shanbeh, MinDays: 1}
+
+// Week returns the week year and the week of year of t in the range [1, 53], in the calendar of t.
+func (r WeekRule) Week(t Time) (int, int) {
+	cal, jdn := t.calendar(), t.jdn()
+
+	year := t.year
+	start := r.yearStart(cal, year)
+	switch {
+	case jdn < start:
+		year--
+		start = r.yearStart(cal, year)
+	case jdn >= r.yearStart(cal, year+1):
+		year++
+		start = r.yearStart(cal, year)
+	}
+
+	return year, (jdn-start)/7 + 1
+}
+
+// WeeksInYear returns the number of weeks of the week year in ArithmeticCalendar, which is 52 or 53.
+func (r WeekRule) WeeksInYear(weekYear int) int {
+	return r.weeksInYear(defaultCalendar, weekYear)
+}
+
+// Date returns the date of the weekday of the week of the week year in ArithmeticCalendar.
+// The week and weekday values may be outside their usual ranges and are normalized.
+func (r WeekRule) Date(weekYear, week int, weekday Weekday) CivilDate {
+	return civilDateOfJDN(r.yearStart(defaultCalendar, weekYear) + (week-1)*7 + r.DayOfWeek(weekday) - 1)
+}
+
+// FormatWeekDate returns the week date of t, e.g. 1403-W12-3 which is the third day of
+// the twelfth week of 1403. The year is formatted as in FormatOrdinalDate.
+func (r WeekRule) FormatWeekDate(t Time) string {
+	year, week := r.Week(t)
+	return fmt.Sprintf("%s-W%02d-%d", formatYear(year), week, r.DayOfWeek(t.wday))
+}
+
+// ParseWeekDate parses a week date in the format of FormatWeekDate and returns the time
+// at 00:00:00 of that day in loc. If loc is nil then the local time is used.
+//
+// Errors are of type *ParseError.
+func (r WeekRule) ParseWeekDate(value string, loc *time.Location) (Time, error) {
+	fail := func(offset int, msg string) (Time, error) {
+		return Time{}, &ParseError{Layout: weekDateLayout, Value: value, Offset: offset, Message: msg}
+	}
+
+	year, n, ok := leadingYear(value, true)
+	if !ok {
+		return fail(0, "invalid year")
+	}
+
+	j := n
+	if len(value) < j+2 || value[j:j+2] != "-W" {
+		return fail(j, `expected "-W"`)
+	}
+
+	j += 2
+	week, n, ok := leadingInt(value[j:], 2, 2)
+	if !ok {
+		return fail(j, "invalid week")
+	}
+	if week < 1 || week > r.WeeksInYear(year) {
+		return fail(j, "week out of range")
+	}
+
+	j += n
+	if len(value) < j+1 || value[j] != '-' {
+		return fail(j, `expected "-"`)
+	}
+
+	j++
+	day, n, ok := leadingInt(value[j:], 1, 1)
+	if !ok {
+		return fail(j, "invalid day of week")
+	}
+	if day < 1 || day > 7 {
+		return fail(j, "day of week out of range")
+	}
+
+	if j += n; j < len(value) {
+		return fail(j, "extra text")
+	}
+
+	weekday := Weekday(mod(int(r.FirstDay)+day-1, 7))
+	return r.Date(year, week, weekday).At(0, 0, 0, 0, loc), nil
+}
+
+// weeksInYear returns the number of weeks of the week year in cal.
+func (r WeekRule) weeksInYear(cal Calendar, weekYear int) int {
+	return (r.yearStart(cal, weekYear+1) - r.yearStart(cal, weekYear)) / 7
+}
+
+// yearStart returns the Julian Day Number of the first day of the first week of the week year in cal.
+func (r WeekRule) yearStart(cal Calendar, weekYear int) int {
+	first := cal.YearStart(weekYear)
+
+	// The number of days of the week of 1 Farvardin which are before 1 Farvardin.
+	before := r.DayOfWeek(jdnWeekday(first)) - 1
+
+	start := first - before
+	if 7-before < r.minDays() {
+		start += 7
+	}
+
+	return start
+}
+
+// DayOfWeek returns the number of the weekday in the week in the range [1, 7], where FirstDay is 1.
+func (r WeekRule) DayOfWeek(weekday Weekday) int {
+	return mod(int(weekday-r.FirstDay), 7) + 1
+}
+
+// minDays returns MinDays in the range [1, 7].
+func (r WeekRule) minDays() int {
+	switch {
+	case r.MinDays < 1:
+		return 1
+	case r.MinDays > 7:
+		return 7
+	default:
+		return r.MinDays
+	}
+}
+
+// WeekYear returns the week year of t by the default week rule.
+func (t Time) WeekYear() int {
+	year, _ := defaultWeekRule.Week(t)
+	return year
+}
+
+// Week returns the week of the week year of t by the default week rule, in the range [1, 53].
+// Unlike YearWeek, the last days of a year may be in the first week of the next year.
+func (t Time) Week() int {
+	_, week := defaultWeekRule.Week(t)
+	return week
+}
+
+// WeeksInYear returns the number of weeks of the week year of t by the default week rule.
+func (t Time) WeeksInYear() int {
+	return defaultWeekRule.weeksInYear(t.calendar(), t.WeekYear())
+}
+
+// FormatWeekDate returns the week date of t by the default week rule, e.g. 1403-W12-3.
+func (t Time) FormatWeekDate() string {
+	return defaultWeekRule.FormatWeekDate(t)
+}
+
+// FromWeekDate returns a new instance of Time at 00:00:00 of the weekday of the week of the week year
+// by the default week rule in loc. If loc is nil then the local time is used.
+func FromWeekDate(weekYear, week int, weekday Weekday, loc *time.Location) Time {
+	return defaultWeekRule.Date(weekYear, week, weekday).At(0, 0, 0, 0, loc)
+}
+
+// ParseWeekDate parses a week date like 1403-W12-3 by the default week rule. See WeekRule.ParseWeekDate.
+func ParseWeekDate(value string, loc *time.Location) (Time, error) {
+	return defaultWeekRule.ParseWeekDate(value, loc)
+}
@@ -0,0 +1,168 @@
+package ptime_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	ptime "github.com/yaa110/go-persian-calendar"
+)
+
+func TestWeek(t *testing.T) {
+	def := ptime.WeekRule{FirstDay: ptime.Shanbeh, MinDays: 1}
+	iso := ptime.WeekRule{FirstDay: ptime.Shanbeh, MinDays: 4}
+
+	vals := []struct {
+		rule     ptime.WeekRule
+		t        ptime.Time
+		weekDate string
+		weeks    int
+	}{
+		{def, ptime.Date(1403, ptime.Farvardin, 1, 12, 0, 0, 0, ptime.Iran()), "1403-W01-5", 52},
+		{def, ptime.Date(1402, ptime.Esfand, 26, 12, 0, 0, 0, ptime.Iran()), "1403-W01-1", 52},
+		{def, ptime.Date(1403, ptime.Khordad, 14, 12, 0, 0, 0, ptime.Iran()), "1403-W12-3", 52},
+		{def, ptime.Date(1403, ptime.Esfand, 28, 12, 0, 0, 0, ptime.Iran()), "1404-W01-4", 53},
+		{iso, ptime.Date(1403, ptime.Farvardin, 1, 12, 0, 0, 0, ptime.Iran()), "1402-W53-5", 53},
+		{iso, ptime.Date(1403, ptime.Farvardin, 4, 12, 0, 0, 0, ptime.Iran()), "1403-W01-1", 52},
+		{iso, ptime.Date(1404, ptime.Farvardin, 1, 12, 0, 0, 0, ptime.Iran()), "1403-W52-7", 52},
+		{ptime.WeekRule{FirstDay: ptime.Doshanbeh, MinDays: 7}, ptime.Date(1403, ptime.Farvardin, 1, 12, 0, 0, 0, ptime.Iran()), "1402-W52-3", 52},
+	}
+
+	for _, v := range vals {
+		year, week := v.rule.Week(v.t)
+		if s := v.rule.FormatWeekDate(v.t); s != v.weekDate || v.rule.WeeksInYear(year) != v.weeks {
+			t.Error(
+				"For", v.t, v.rule,
+				"expected", v.weekDate, v.weeks,
+				"got", s, v.rule.WeeksInYear(year),
+			)
+		}
+
+		if d := v.rule.Date(year, week, v.t.Weekday()); d != v.t.CivilDate() {
+			t.Error(
+				"For", v.weekDate,
+				"expected", v.t.CivilDate(),
+				"got", d,
+			)
+		}
+
+		pt, err := v.rule.ParseWeekDate(v.weekDate, ptime.Iran())
+		if err != nil || pt.CivilDate() != v.t.CivilDate() || pt.Hour() != 0 {
+			t.Error(
+				"For", v.weekDate,
+				"expected", v.t.CivilDate(),
+				"got", pt, err,
+			)
+		}
+	}
+
+	ti := ptime.Date(1403, ptime.Esfand, 28, 12, 0, 0, 0, ptime.Iran())
+	if ti.WeekYear() != 1404 || ti.Week() != 1 || ti.WeeksInYear() != 53 || ti.FormatWeekDate() != "1404-W01-4" {
+		t.Error(
+			"Expected", 1404, 1, 53, "1404-W01-4",
+			"got", ti.WeekYear(), ti.Week(), ti.WeeksInYear(), ti.FormatWeekDate(),
+		)
+	}
+
+	if pt := ptime.FromWeekDate(1404, 1, ptime.Seshanbeh, ptime.Iran()); pt.CivilDate() != ti.CivilDate() {
+		t.Error(
+			"Expected", ti.CivilDate(),
+			"got", pt,
+		)
+	}
+}
+
+func TestWeekConsistency(t *testing.T) {
+	rules := []ptime.WeekRule{
+		{FirstDay: ptime.Shanbeh, MinDays: 1},
+		{FirstDay: ptime.Shanbeh, MinDays: 4},
+		{FirstDay: ptime.Yekshanbeh, MinDays: 7},
+		{FirstDay: ptime.Jomeh, MinDays: 2},
+	}
+
+	for _, r := range rules {
+		ti := ptime.Date(1390, ptime.Farvardin, 1, 12, 0, 0, 0, ptime.Iran())
+		prevYear, prevWeek := r.Week(ti.Yesterday())
+
+		for i := 0; i < 20*366; i++ {
+			year, week := r.Week(ti)
+
+			if r.DayOfWeek(ti.Weekday()) == 1 {
+				if week == 1 {
+					if year != prevYear+1 || prevWeek != r.WeeksInYear(prevYear) {
+						t.Fatal("For", ti, r, "expected a new week year, got", year, week, "after", prevYear, prevWeek)
+					}
+				} else if year != prevYear || week != prevWeek+1 {
+					t.Fatal("For", ti, r, "expected the next week, got", year, week, "after", prevYear, prevWeek)
+				}
+			} else if year != prevYear || week != prevWeek {
+				t.Fatal("For", ti, r, "expected the same week, got", year, week, "after", prevYear, prevWeek)
+			}
+
+			if week < 1 || week > 53 || year < ti.Year()-1 || year > ti.Year()+1 {
+				t.Fatal("For", ti, r, "got", year, week)
+			}
+
+			prevYear, prevWeek = year, week
+			ti = ti.Tomorrow()
+		}
+	}
+}
+
+func TestParseWeekDate(t *testing.T) {
+	invalid := []string{
+		"1403-W00-1",
+		"1403-W53-1",
+		"1403-W12-0",
+		"1403-W12-8",
+		"1403-12-3",
+		"1403-W1-3",
+		"1403-W12-3x",
+		"۱۴۰۳-W12",
+	}
+
+	for _, v := range invalid {
+		if _, err := ptime.ParseWeekDate(v, ptime.Iran()); err == nil {
+			t.Error("Expected an error for", v)
+		}
+	}
+
+	pt, err := ptime.ParseWeekDate("۱۴۰۳-W۱۲-۳", ptime.Iran())
+	if err != nil || pt.String() != "1403-03-14T00:00:00.0+03:30" {
+		t.Error(
+			"Expected", "1403-03-14T00:00:00.0+03:30",
+			"got", pt, err,
+		)
+	}
+}
+
+func TestWeekDateYears(t *testing.T) {
+	vals := map[int]string{
+		-1000: "-1000-W",
+		-5:    "-0005-W",
+		999:   "0999-W",
+		12000: "12000-W",
+	}
+
+	for year, prefix := range vals {
+		ti := ptime.Date(year, ptime.Tir, 10, 0, 0, 0, 0, time.UTC)
+
+		s := ti.FormatWeekDate()
+		if !strings.HasPrefix(s, prefix) {
+			t.Error(
+				"For", year,
+				"expected", prefix,
+				"got", s,
+			)
+		}
+
+		pt, err := ptime.ParseWeekDate(s, time.UTC)
+		if err != nil || !pt.Equal(ti) {
+			t.Error(
+				"For", s,
+				"expected", ti,
+				"got", pt, err,
+			)
+		}
+	}
+}